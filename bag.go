@@ -0,0 +1,239 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"fmt"
+)
+
+// BagEntry is an element of a Bag together with its multiplicity.
+type BagEntry struct {
+	E Element
+	N int
+}
+
+// Bag is a multiset, a set where elements may occur more than once.
+//
+// A Bag is a slice of entries, each an element with a positive multiplicity.
+// As with Set and SetM, order is irrelevant and Equal must return false for
+// the elements of any pair of entries.  Ok validates these rules.
+type Bag []BagEntry
+
+// NewBag returns a new bag with the given elements.
+//
+// Unlike NewSetM, duplicate arguments are counted.
+func NewBag(es ...Element) Bag {
+	var b Bag
+	for _, e := range es {
+		b.Add(e)
+	}
+	return b
+}
+
+// BagOf returns a bag containing each element of s once.
+func BagOf(s SetM) Bag {
+	b := make(Bag, len(s))
+	for i, e := range s {
+		b[i] = BagEntry{e, 1}
+	}
+	return b
+}
+
+// Ok validates that multiplicities are positive and that Equal returns false
+// for all pairs of elements.
+func (b Bag) Ok() bool {
+	for i, x := range b {
+		if x.N <= 0 {
+			return false
+		}
+		for _, y := range b[:i] {
+			if x.E.Equal(y.E) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// index returns the index of the entry for e, or -1.
+func (b Bag) index(e Element) int {
+	for i, x := range b {
+		if e.Equal(x.E) {
+			return i
+		}
+	}
+	return -1
+}
+
+// Add adds a single occurrence of e to the bag.
+func (r *Bag) Add(e Element) { r.AddN(e, 1) }
+
+// AddN adds n occurrences of e to the bag.
+//
+// If n is zero or less, AddN has no effect.
+func (r *Bag) AddN(e Element, n int) {
+	if n <= 0 {
+		return
+	}
+	b := *r
+	if i := b.index(e); i >= 0 {
+		b = b.Copy()
+		b[i].N += n
+		*r = b
+		return
+	}
+	// always allocate new backing array, as with SetM.Add
+	*r = append(b[:len(b):len(b)], BagEntry{e, n})
+}
+
+// Cardinality returns the total number of occurrences of all elements.
+func (b Bag) Cardinality() (n int) {
+	for _, x := range b {
+		n += x.N
+	}
+	return
+}
+
+// Copy returns a copy of a bag.
+//
+// The returned Bag is based on a newly allocated slice.  Elements are shared.
+func (b Bag) Copy() Bag { return append(Bag{}, b...) }
+
+// Count returns the multiplicity of e, zero if e is not in the bag.
+func (b Bag) Count(e Element) int {
+	if i := b.index(e); i >= 0 {
+		return b[i].N
+	}
+	return 0
+}
+
+// Difference returns a new bag where the multiplicity of each element is its
+// multiplicity in b minus its multiplicity in c, or zero if that would be
+// negative.
+func (b Bag) Difference(c Bag) (d Bag) {
+	for _, x := range b {
+		if n := x.N - c.Count(x.E); n > 0 {
+			d = append(d, BagEntry{x.E, n})
+		}
+	}
+	return
+}
+
+// Equal satisfies the Element interface, allowing Bag values to be elements
+// of sets.
+//
+// Bags are equal if they have the same elements with the same multiplicities.
+// If the dynamic type of the argument is not Bag, Equal returns false.
+func (b Bag) Equal(e Element) bool {
+	c, ok := e.(Bag)
+	if !ok || len(b) != len(c) {
+		return false
+	}
+	for _, x := range b {
+		if c.Count(x.E) != x.N {
+			return false
+		}
+	}
+	return true
+}
+
+// Intersect returns a new bag where the multiplicity of each element is the
+// minimum of its multiplicities in b and c.
+func (b Bag) Intersect(c Bag) (i Bag) {
+	for _, x := range b {
+		if n := c.Count(x.E); n > 0 {
+			i = append(i, BagEntry{x.E, min(x.N, n)})
+		}
+	}
+	return
+}
+
+// IsSubbag returns true if every element of b occurs in c at least as many
+// times as in b.
+func (b Bag) IsSubbag(c Bag) bool {
+	for _, x := range b {
+		if c.Count(x.E) < x.N {
+			return false
+		}
+	}
+	return true
+}
+
+// RemoveN removes up to n occurrences of e from the bag.
+//
+// Returns the number of occurrences removed.  An element whose multiplicity
+// reaches zero is removed from the bag entirely.
+func (r *Bag) RemoveN(e Element, n int) int {
+	b := *r
+	i := b.index(e)
+	if i < 0 || n <= 0 {
+		return 0
+	}
+	if n < b[i].N {
+		b = b.Copy()
+		b[i].N -= n
+		*r = b
+		return n
+	}
+	n = b[i].N
+	last := len(b) - 1
+	b[i], b[last] = b[last], b[i]
+	*r = b[:last]
+	return n
+}
+
+// String satisfies fmt.Stringer, providing a printable representation of a
+// bag.
+//
+// Each element is printed as many times as it occurs.
+func (b Bag) String() string {
+	r := "{"
+	first := true
+	for _, j := range randPerm(len(b)) {
+		s := fmt.Sprint(b[j].E)
+		for k := 0; k < b[j].N; k++ {
+			if !first {
+				r += " "
+			}
+			first = false
+			r += s
+		}
+	}
+	return r + "}"
+}
+
+// Sum returns a new bag where the multiplicity of each element is the sum
+// of its multiplicities in b and c.
+//
+// Called additive union in some texts.
+func (b Bag) Sum(c Bag) Bag {
+	s := b.Copy()
+	for _, x := range c {
+		s.AddN(x.E, x.N)
+	}
+	return s
+}
+
+// Support returns the set of distinct elements of the bag.
+func (b Bag) Support() SetM {
+	s := make(SetM, len(b))
+	for i, x := range b {
+		s[i] = x.E
+	}
+	return s
+}
+
+// Union returns a new bag where the multiplicity of each element is the
+// maximum of its multiplicities in b and c.
+func (b Bag) Union(c Bag) Bag {
+	u := b.Copy()
+	for _, x := range c {
+		if i := u.index(x.E); i < 0 {
+			u = append(u, x)
+		} else if x.N > u[i].N {
+			u[i].N = x.N
+		}
+	}
+	return u
+}
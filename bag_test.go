@@ -0,0 +1,56 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func TestBag(t *testing.T) {
+	b := set.NewBag(intEle(1), intEle(2), intEle(1), intEle(3), intEle(1))
+	if !b.Ok() || b.Count(intEle(1)) != 3 || b.Cardinality() != 5 {
+		t.Fatal("NewBag:", b)
+	}
+	if !b.Support().Equal(ints(1, 2, 3)) {
+		t.Fatal("Support:", b.Support())
+	}
+	c := set.NewBag(intEle(1), intEle(2), intEle(2), intEle(4))
+	if u := b.Union(c); !u.Equal(set.NewBag(ints(1, 1, 1, 2, 2, 3, 4)...)) {
+		t.Fatal("Union:", u)
+	}
+	if s := b.Sum(c); s.Cardinality() != 9 || s.Count(intEle(1)) != 4 {
+		t.Fatal("Sum:", s)
+	}
+	if i := b.Intersect(c); !i.Equal(set.NewBag(intEle(1), intEle(2))) {
+		t.Fatal("Intersect:", i)
+	}
+	if d := b.Difference(c); !d.Equal(set.NewBag(intEle(1), intEle(1), intEle(3))) {
+		t.Fatal("Difference:", d)
+	}
+	if !b.Intersect(c).IsSubbag(b) || c.IsSubbag(b) {
+		t.Fatal("IsSubbag")
+	}
+	if n := b.RemoveN(intEle(1), 2); n != 2 || b.Count(intEle(1)) != 1 {
+		t.Fatal("RemoveN:", b)
+	}
+	if n := b.RemoveN(intEle(1), 5); n != 1 || b.Count(intEle(1)) != 0 || !b.Ok() {
+		t.Fatal("RemoveN all:", b)
+	}
+	if set.BagOf(ints(1, 2)).Cardinality() != 2 {
+		t.Fatal("BagOf")
+	}
+}
+
+func TestBagShared(t *testing.T) {
+	// AddN and RemoveN must not modify copies of a bag.
+	b := set.NewBag(intEle(1), intEle(1))
+	c := b
+	b.Add(intEle(1))
+	b.RemoveN(intEle(1), 2)
+	if c.Count(intEle(1)) != 2 {
+		t.Fatal("copy modified:", c)
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/binary"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// Binary encoding
+//
+// Set and SetM implement encoding.BinaryMarshaler and gob.GobEncoder with
+// the same format.  All integers are unsigned varints as written by
+// binary.AppendUvarint.  A set is encoded as
+//
+//   count, element, element, ...
+//
+// and each element as
+//
+//   len(name), name, len(payload), payload
+//
+// where name is the name the element's dynamic type was registered with by
+// RegisterBinary or RegisterBinaryName.  The payload is
+//
+//   - for Set, SetM, and Tuple, the encoding above, recursively,
+//   - for OrderedPair, the elements A and B encoded as above,
+//   - for other types implementing encoding.BinaryMarshaler, the output of
+//     MarshalBinary,
+//   - for all other types, a gob stream of the single value.
+//
+// Set, SetM, OrderedPair, and Tuple are registered by the package under
+// their own names.
+
+var binReg = struct {
+	sync.RWMutex
+	types map[string]reflect.Type
+	names map[reflect.Type]string
+}{
+	types: map[string]reflect.Type{},
+	names: map[reflect.Type]string{},
+}
+
+// RegisterBinary registers the dynamic type of e for binary encoding.
+//
+// As with gob.Register, the type is registered under a name formed from its
+// package path and type name.
+//
+// RegisterBinary panics if the type is already registered.
+func RegisterBinary(e Element) {
+	t := reflect.TypeOf(e)
+	star := ""
+	if t.Name() == "" && t.Kind() == reflect.Pointer {
+		star = "*"
+		t = t.Elem()
+	}
+	name := t.String()
+	if t.Name() != "" && t.PkgPath() != "" {
+		name = t.PkgPath() + "." + t.Name()
+	}
+	RegisterBinaryName(star+name, e)
+}
+
+// RegisterBinaryName registers the dynamic type of e for binary encoding
+// under the given name.
+//
+// RegisterBinaryName panics if name or the type is already registered.
+func RegisterBinaryName(name string, e Element) {
+	t := reflect.TypeOf(e)
+	binReg.Lock()
+	defer binReg.Unlock()
+	if _, ok := binReg.types[name]; ok {
+		panic("set: duplicate registration of binary name " + name)
+	}
+	if _, ok := binReg.names[t]; ok {
+		panic("set: duplicate registration of binary type " + t.String())
+	}
+	binReg.types[name] = t
+	binReg.names[t] = name
+}
+
+func init() {
+	RegisterBinaryName("Set", Set{})
+	RegisterBinaryName("SetM", SetM{})
+	RegisterBinaryName("OrderedPair", OrderedPair{})
+	RegisterBinaryName("Tuple", Tuple{})
+}
+
+var (
+	errTruncated = errors.New("set: truncated binary input")
+	errExtra     = errors.New("set: extra data after binary input")
+)
+
+func appendBytes(b, p []byte) []byte {
+	return append(binary.AppendUvarint(b, uint64(len(p))), p...)
+}
+
+func appendElement(b []byte, e Element) ([]byte, error) {
+	binReg.RLock()
+	name, ok := binReg.names[reflect.TypeOf(e)]
+	binReg.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("set: unregistered element type %T", e)
+	}
+	var p []byte
+	var err error
+	if m, ok := e.(encoding.BinaryMarshaler); ok {
+		p, err = m.MarshalBinary()
+	} else {
+		var buf bytes.Buffer
+		err = gob.NewEncoder(&buf).Encode(e)
+		p = buf.Bytes()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return appendBytes(appendBytes(b, []byte(name)), p), nil
+}
+
+func appendElements(b []byte, es []Element) ([]byte, error) {
+	b = binary.AppendUvarint(b, uint64(len(es)))
+	for _, e := range es {
+		var err error
+		if b, err = appendElement(b, e); err != nil {
+			return nil, err
+		}
+	}
+	return b, nil
+}
+
+// binDecoder consumes binary input.
+type binDecoder []byte
+
+func (d *binDecoder) uvarint() (uint64, error) {
+	x, n := binary.Uvarint(*d)
+	if n <= 0 {
+		return 0, errTruncated
+	}
+	*d = (*d)[n:]
+	return x, nil
+}
+
+func (d *binDecoder) bytes() ([]byte, error) {
+	n, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(*d)) {
+		return nil, errTruncated
+	}
+	p := (*d)[:n]
+	*d = (*d)[n:]
+	return p, nil
+}
+
+func (d *binDecoder) element() (Element, error) {
+	name, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+	p, err := d.bytes()
+	if err != nil {
+		return nil, err
+	}
+	binReg.RLock()
+	t, ok := binReg.types[string(name)]
+	binReg.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("set: unregistered binary name %q", name)
+	}
+	u := reflect.TypeFor[encoding.BinaryUnmarshaler]()
+	switch {
+	case t.Kind() == reflect.Pointer && t.Implements(u):
+		v := reflect.New(t.Elem())
+		err = v.Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(p)
+		return v.Interface().(Element), err
+	case reflect.PointerTo(t).Implements(u):
+		v := reflect.New(t)
+		err = v.Interface().(encoding.BinaryUnmarshaler).UnmarshalBinary(p)
+		return v.Elem().Interface().(Element), err
+	}
+	v := reflect.New(t)
+	if err := gob.NewDecoder(bytes.NewReader(p)).DecodeValue(v); err != nil {
+		return nil, err
+	}
+	return v.Elem().Interface().(Element), nil
+}
+
+func (d *binDecoder) elements() ([]Element, error) {
+	n, err := d.uvarint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(*d)) { // each element takes at least one byte
+		return nil, errTruncated
+	}
+	es := make([]Element, n)
+	for i := range es {
+		if es[i], err = d.element(); err != nil {
+			return nil, err
+		}
+	}
+	return es, nil
+}
+
+// unmarshalBinaryElements decodes all of data as a count and elements.
+func unmarshalBinaryElements(data []byte) ([]Element, error) {
+	d := binDecoder(data)
+	es, err := d.elements()
+	if err != nil {
+		return nil, err
+	}
+	if len(d) > 0 {
+		return nil, errExtra
+	}
+	return es, nil
+}
+
+// MarshalBinary satisfies encoding.BinaryMarshaler.
+func (s Set) MarshalBinary() ([]byte, error) { return appendElements(nil, s) }
+
+// UnmarshalBinary satisfies encoding.BinaryUnmarshaler.
+//
+// It returns an error if the decoded set is not Ok, that is, if it contains
+// duplicate elements.
+func (p *Set) UnmarshalBinary(data []byte) error {
+	es, err := unmarshalBinaryElements(data)
+	if err != nil {
+		return err
+	}
+	if s := Set(es); !s.Ok() {
+		return errDuplicate
+	}
+	*p = es
+	return nil
+}
+
+// GobEncode satisfies gob.GobEncoder.  It is the same as MarshalBinary.
+func (s Set) GobEncode() ([]byte, error) { return s.MarshalBinary() }
+
+// GobDecode satisfies gob.GobDecoder.  It is the same as UnmarshalBinary.
+func (p *Set) GobDecode(data []byte) error { return p.UnmarshalBinary(data) }
+
+// MarshalBinary satisfies encoding.BinaryMarshaler.
+func (s SetM) MarshalBinary() ([]byte, error) { return appendElements(nil, s) }
+
+// UnmarshalBinary satisfies encoding.BinaryUnmarshaler.
+//
+// It returns an error if the decoded set is not Ok, that is, if it contains
+// duplicate elements.
+func (r *SetM) UnmarshalBinary(data []byte) error {
+	es, err := unmarshalBinaryElements(data)
+	if err != nil {
+		return err
+	}
+	if s := SetM(es); !s.Ok() {
+		return errDuplicate
+	}
+	*r = es
+	return nil
+}
+
+// GobEncode satisfies gob.GobEncoder.  It is the same as MarshalBinary.
+func (s SetM) GobEncode() ([]byte, error) { return s.MarshalBinary() }
+
+// GobDecode satisfies gob.GobDecoder.  It is the same as UnmarshalBinary.
+func (r *SetM) GobDecode(data []byte) error { return r.UnmarshalBinary(data) }
+
+// MarshalBinary satisfies encoding.BinaryMarshaler.
+func (p OrderedPair) MarshalBinary() ([]byte, error) {
+	b, err := appendElement(nil, p.A)
+	if err != nil {
+		return nil, err
+	}
+	return appendElement(b, p.B)
+}
+
+// UnmarshalBinary satisfies encoding.BinaryUnmarshaler.
+func (p *OrderedPair) UnmarshalBinary(data []byte) error {
+	d := binDecoder(data)
+	a, err := d.element()
+	if err != nil {
+		return err
+	}
+	b, err := d.element()
+	if err != nil {
+		return err
+	}
+	if len(d) > 0 {
+		return errExtra
+	}
+	*p = OrderedPair{a, b}
+	return nil
+}
+
+// MarshalBinary satisfies encoding.BinaryMarshaler.
+func (t Tuple) MarshalBinary() ([]byte, error) { return appendElements(nil, t) }
+
+// UnmarshalBinary satisfies encoding.BinaryUnmarshaler.
+func (t *Tuple) UnmarshalBinary(data []byte) error {
+	es, err := unmarshalBinaryElements(data)
+	if err != nil {
+		return err
+	}
+	*t = es
+	return nil
+}
@@ -0,0 +1,99 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+// point is an element type with its own binary encoding.
+type point struct{ x, y byte }
+
+func (p point) Equal(e set.Element) bool { return p == e }
+
+func (p point) MarshalBinary() ([]byte, error) { return []byte{p.x, p.y}, nil }
+
+func (p *point) UnmarshalBinary(b []byte) error {
+	if len(b) != 2 {
+		return io.ErrUnexpectedEOF
+	}
+	p.x, p.y = b[0], b[1]
+	return nil
+}
+
+func init() {
+	set.RegisterBinary(intEle(0))
+	set.RegisterBinaryName("point", point{})
+}
+
+func TestBinary(t *testing.T) {
+	s := set.SetM{
+		intEle(1),
+		point{2, 3},
+		set.SetM{intEle(2), set.SetM{}},
+		set.Set{intEle(4)},
+		set.OrderedPair{intEle(5), set.SetM{intEle(6)}},
+		set.Tuple{intEle(7), intEle(7)},
+	}
+	b, err := s.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r set.SetM
+	if err := r.UnmarshalBinary(b); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Equal(s) {
+		t.Fatal("round trip:", r)
+	}
+	// gob, with sets as struct fields
+	type cache struct {
+		A set.Set
+		M set.SetM
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(cache{set.Set(s), s}); err != nil {
+		t.Fatal(err)
+	}
+	var c cache
+	if err := gob.NewDecoder(&buf).Decode(&c); err != nil {
+		t.Fatal(err)
+	}
+	if !c.A.Equal(set.Set(s)) || !c.M.Equal(s) {
+		t.Fatal("gob round trip:", c)
+	}
+}
+
+func TestBinaryErrors(t *testing.T) {
+	if _, err := (set.SetM{fEle(1)}).MarshalBinary(); err == nil ||
+		!strings.Contains(err.Error(), "unregistered") {
+		t.Fatal("unregistered type:", err)
+	}
+	one, _ := set.SetM{intEle(1)}.MarshalBinary()
+	var s set.SetM
+	// duplicates
+	dup := append([]byte{2}, one[1:]...)
+	dup = append(dup, one[1:]...)
+	if err := s.UnmarshalBinary(dup); err == nil {
+		t.Error("duplicates accepted")
+	}
+	// every truncation fails
+	for i := range one {
+		if err := s.UnmarshalBinary(one[:i]); err == nil {
+			t.Error("truncated input accepted:", one[:i])
+		}
+	}
+	if err := s.UnmarshalBinary(append(one, 0)); err == nil {
+		t.Error("extra data accepted")
+	}
+	if err := s.UnmarshalBinary([]byte{1, 1, 'x', 0}); err == nil {
+		t.Error("unregistered name accepted")
+	}
+}
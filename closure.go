@@ -0,0 +1,93 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+// ReflexiveClosure returns the smallest relation containing r that is
+// reflexive over carrier.
+//
+// That is, r with the pair (a, a) added for every a in carrier.
+func (r Relation) ReflexiveClosure(carrier SetM) Relation {
+	c := Relation(SetM(r).Copy())
+	for _, a := range carrier {
+		c.Add(a, a)
+	}
+	return c
+}
+
+// SymmetricClosure returns the smallest symmetric relation containing r.
+//
+// That is, the union of r and its inverse.
+func (r Relation) SymmetricClosure() Relation {
+	return Relation(SetM(r).Union(SetM(r.Inverse())))
+}
+
+// TransitiveClosure returns the smallest transitive relation containing r.
+//
+// The algorithm here is Warshall's.  Elements of the field of r are indexed
+// and closure is computed on a boolean matrix, taking time cubic in the size
+// of the field.  It is a good choice for dense relations.
+//
+// See Relation.TransitiveClosureSN for an alternative algorithm.
+func (r Relation) TransitiveClosure() Relation {
+	f := r.Field()
+	index := func(e Element) int {
+		for i, x := range f {
+			if e.Equal(x) {
+				return i
+			}
+		}
+		return -1
+	}
+	m := make([][]bool, len(f))
+	for i := range m {
+		m[i] = make([]bool, len(f))
+	}
+	for _, e := range r {
+		p := e.(OrderedPair)
+		m[index(p.A)][index(p.B)] = true
+	}
+	for k := range f {
+		for i := range f {
+			if !m[i][k] {
+				continue
+			}
+			for j := range f {
+				if m[k][j] {
+					m[i][j] = true
+				}
+			}
+		}
+	}
+	var c Relation
+	for i, row := range m {
+		for j, b := range row {
+			if b {
+				// elements of f are distinct so pairs are distinct.
+				c = append(c, OrderedPair{f[i], f[j]})
+			}
+		}
+	}
+	return c
+}
+
+// TransitiveClosureSN returns the smallest transitive relation containing r.
+//
+// The algorithm here is a semi-naive fixpoint computation.  Each round
+// composes only the pairs newly found in the previous round with r,
+// stopping when a round finds nothing new.  It is a good choice for sparse
+// relations with short paths.
+func (r Relation) TransitiveClosureSN() Relation {
+	c := Relation(SetM(r).Copy())
+	delta := c
+	for len(delta) > 0 {
+		var next Relation
+		for _, e := range delta.Compose(r) {
+			if p := e.(OrderedPair); c.Add(p.A, p.B) {
+				next = append(next, p)
+			}
+		}
+		delta = next
+	}
+	return c
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func TestReflexiveSymmetricClosure(t *testing.T) {
+	r := rel([2]int{1, 2}, [2]int{2, 3})
+	c := ints(1, 2, 3, 4)
+	rc := r.ReflexiveClosure(c)
+	if !rc.IsReflexive(c) || len(rc) != 6 || !set.SetM(r).IsProperSubset(set.SetM(rc)) {
+		t.Fatal("ReflexiveClosure:", rc)
+	}
+	if len(r) != 2 {
+		t.Fatal("ReflexiveClosure modified receiver")
+	}
+	sc := r.SymmetricClosure()
+	if !sc.IsSymmetric(c) || len(sc) != 4 {
+		t.Fatal("SymmetricClosure:", sc)
+	}
+}
+
+func TestTransitiveClosure(t *testing.T) {
+	// build graph 1 → 2 → 3 → 4, 5 → 5
+	r := rel([2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{5, 5})
+	want := rel([2]int{1, 2}, [2]int{2, 3}, [2]int{3, 4}, [2]int{5, 5},
+		[2]int{1, 3}, [2]int{2, 4}, [2]int{1, 4})
+	if c := r.TransitiveClosure(); !c.Ok() || !c.Equal(want) {
+		t.Fatal("TransitiveClosure:", c)
+	}
+	if c := r.TransitiveClosureSN(); !c.Ok() || !c.Equal(want) {
+		t.Fatal("TransitiveClosureSN:", c)
+	}
+	if c := r.TransitiveClosure(); !c.Image(ints(1)).Equal(ints(2, 3, 4)) {
+		t.Fatal("reachable from 1:", c.Image(ints(1)))
+	}
+}
+
+func TestTransitiveClosureRandom(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	for n := 0; n < 20; n++ {
+		var r set.Relation
+		for i := 0; i < 15; i++ {
+			r.Add(intEle(rnd.Intn(10)), intEle(rnd.Intn(10)))
+		}
+		w := r.TransitiveClosure()
+		sn := r.TransitiveClosureSN()
+		if !w.Equal(sn) {
+			t.Fatal("algorithms differ:", r)
+		}
+		f := r.Field()
+		if !w.IsTransitive(f) {
+			t.Fatal("not transitive:", w)
+		}
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/soniakeys/set"
+)
+
+// atom is an element of a set literal that is not itself a set.
+type atom string
+
+func (a atom) Equal(e set.Element) bool { return a == e }
+
+// literal parses set literals.  Duplicates are merged, as {1 1} is {1}.
+var literal = set.Parser{
+	Atom:            func(s string) (set.Element, error) { return atom(s), nil },
+	MergeDuplicates: true,
+}
+
+// calcError is an error at a position in a line of input.
+type calcError struct {
+	off int // byte offset, set by the lexer and parser
+	col int // 1-based column in runes, set by calc.line
+	msg string
+}
+
+func (e *calcError) Error() string { return fmt.Sprintf("%d: %s", e.col, e.msg) }
+
+// operators, with ASCII alternatives mapped to the canonical symbol.
+const (
+	opUnion     = '∪'
+	opIntersect = '∩'
+	opDiff      = '\\'
+	opSymDiff   = '△'
+	opProduct   = '×'
+)
+
+var opAlias = map[rune]rune{
+	'∪': opUnion, '|': opUnion,
+	'∩': opIntersect, '&': opIntersect,
+	'\\': opDiff, '-': opDiff,
+	'△': opSymDiff, '∆': opSymDiff, '^': opSymDiff,
+	'×': opProduct, '*': opProduct,
+}
+
+type tokKind int
+
+const (
+	tEOF   tokKind = iota
+	tIdent         // variable name, or P for power set
+	tSet           // set literal, with value in token.val
+	tOp            // operator or punctuation, in token.op
+)
+
+type token struct {
+	kind tokKind
+	pos  int // byte offset
+	text string
+	op   rune
+	val  set.SetM
+}
+
+// lex splits a line into tokens.  Comments start with # and run to the end
+// of the line.
+func lex(src string) ([]token, error) {
+	var ts []token
+	for i := 0; i < len(src); {
+		r, n := utf8.DecodeRuneInString(src[i:])
+		switch {
+		case r == '#':
+			i = len(src)
+		case unicode.IsSpace(r):
+			i += n
+		case r == '{':
+			j, depth := i, 0
+			for ; j < len(src); j++ {
+				if src[j] == '{' {
+					depth++
+				} else if src[j] == '}' {
+					depth--
+					if depth == 0 {
+						break
+					}
+				}
+			}
+			if j == len(src) {
+				return nil, &calcError{off: i, msg: "unclosed {"}
+			}
+			// commas may separate elements, as in {1, 2, 3}
+			text := strings.ReplaceAll(src[i:j+1], ",", " ")
+			s, err := literal.ParseSetM(text)
+			if err != nil {
+				var se *set.SyntaxError
+				if errors.As(err, &se) {
+					return nil, &calcError{off: i + se.Offset, msg: se.Msg}
+				}
+				return nil, &calcError{off: i, msg: err.Error()}
+			}
+			ts = append(ts, token{kind: tSet, pos: i, text: src[i : j+1], val: s})
+			i = j + 1
+		case unicode.IsLetter(r) || r == '_':
+			j := i + n
+			for j < len(src) {
+				r, n := utf8.DecodeRuneInString(src[j:])
+				if !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_' {
+					break
+				}
+				j += n
+			}
+			ts = append(ts, token{kind: tIdent, pos: i, text: src[i:j]})
+			i = j
+		case strings.ContainsRune("()=,;", r) || opAlias[r] != 0:
+			if a := opAlias[r]; a != 0 {
+				r = a
+			}
+			ts = append(ts, token{kind: tOp, pos: i, text: src[i : i+n], op: r})
+			i += n
+		default:
+			return nil, &calcError{off: i, msg: fmt.Sprintf("unexpected %q", r)}
+		}
+	}
+	return append(ts, token{kind: tEOF, pos: len(src)}), nil
+}
+
+// calc holds variables assigned by statements.
+type calc struct {
+	vars map[string]set.SetM
+}
+
+func newCalc() *calc { return &calc{vars: map[string]set.SetM{}} }
+
+// line evaluates a line of input.
+//
+// A line is a list of statements separated by semicolons.  A statement is
+// either an assignment, name = expr, or a list of expressions separated by
+// commas.  The values of the expressions are returned in order.
+func (c *calc) line(src string) (vals []set.SetM, err error) {
+	defer func() {
+		if e, ok := err.(*calcError); ok {
+			e.col = utf8.RuneCountInString(src[:e.off]) + 1
+		}
+	}()
+	ts, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{calc: c, ts: ts}
+	for {
+		switch t := p.peek(); {
+		case t.kind == tEOF:
+			return vals, nil
+		case t.kind == tOp && t.op == ';':
+			p.next()
+			continue
+		case t.kind == tIdent && p.ts[p.i+1].kind == tOp && p.ts[p.i+1].op == '=':
+			p.i += 2
+			v, err := p.expr()
+			if err != nil {
+				return nil, err
+			}
+			c.vars[t.text] = v
+		default:
+			for {
+				v, err := p.expr()
+				if err != nil {
+					return nil, err
+				}
+				vals = append(vals, v)
+				if t := p.peek(); t.kind != tOp || t.op != ',' {
+					break
+				}
+				p.next()
+			}
+		}
+		if t := p.peek(); t.kind != tEOF && (t.kind != tOp || t.op != ';') {
+			return nil, p.unexpected()
+		}
+	}
+}
+
+// parser evaluates expressions by recursive descent.
+//
+// From lowest to highest precedence, the binary operators are
+//
+//	∪ \ △   union, difference, symmetric difference
+//	∩       intersection
+//	×       cartesian product
+//
+// All are left associative.
+type parser struct {
+	*calc
+	ts []token
+	i  int
+}
+
+func (p *parser) peek() token { return p.ts[p.i] }
+
+func (p *parser) next() token {
+	t := p.ts[p.i]
+	if t.kind != tEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) unexpected() error {
+	t := p.peek()
+	if t.kind == tEOF {
+		return &calcError{off: t.pos, msg: "unexpected end of input"}
+	}
+	return &calcError{off: t.pos, msg: fmt.Sprintf("unexpected %s", t.text)}
+}
+
+// isOp returns true if the next token is one of the operators ops.
+func (p *parser) isOp(ops ...rune) bool {
+	t := p.peek()
+	if t.kind != tOp {
+		return false
+	}
+	for _, op := range ops {
+		if t.op == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *parser) expr() (set.SetM, error) {
+	v, err := p.term()
+	for err == nil && p.isOp(opUnion, opDiff, opSymDiff) {
+		op := p.next().op
+		var w set.SetM
+		if w, err = p.term(); err != nil {
+			break
+		}
+		switch op {
+		case opUnion:
+			v = v.Union(w)
+		case opDiff:
+			v = v.Difference(w)
+		default:
+			v = v.SymmetricDifference(w)
+		}
+	}
+	return v, err
+}
+
+func (p *parser) term() (set.SetM, error) {
+	v, err := p.factor()
+	for err == nil && p.isOp(opIntersect) {
+		p.next()
+		var w set.SetM
+		if w, err = p.factor(); err == nil {
+			v = v.Intersect(w)
+		}
+	}
+	return v, err
+}
+
+func (p *parser) factor() (set.SetM, error) {
+	v, err := p.primary()
+	for err == nil && p.isOp(opProduct) {
+		p.next()
+		var w set.SetM
+		if w, err = p.primary(); err == nil {
+			v = v.CartesianProduct(w)
+		}
+	}
+	return v, err
+}
+
+func (p *parser) primary() (set.SetM, error) {
+	t := p.peek()
+	switch {
+	case t.kind == tSet:
+		p.next()
+		return t.val, nil
+	case t.kind == tIdent && t.text == "P" && p.ts[p.i+1].kind == tOp && p.ts[p.i+1].op == '(':
+		p.next()
+		v, err := p.primary()
+		if err != nil {
+			return nil, err
+		}
+		return v.PowerSet(), nil
+	case t.kind == tIdent:
+		p.next()
+		v, ok := p.vars[t.text]
+		if !ok {
+			return nil, &calcError{off: t.pos, msg: "undefined: " + t.text}
+		}
+		return v, nil
+	case t.kind == tOp && t.op == '(':
+		p.next()
+		v, err := p.expr()
+		if err != nil {
+			return nil, err
+		}
+		if !p.isOp(')') {
+			return nil, p.unexpected()
+		}
+		p.next()
+		return v, nil
+	}
+	return nil, p.unexpected()
+}
+
+// format returns a deterministic representation of e.
+//
+// Elements of sets are sorted, numbers numerically and before other
+// elements.  Ordered pairs are printed in parentheses.
+func format(e set.Element) string {
+	switch e := e.(type) {
+	case set.SetM:
+		s := make([]string, len(e))
+		for i, x := range e {
+			s[i] = format(x)
+		}
+		sort.Slice(s, func(i, j int) bool { return less(s[i], s[j]) })
+		return "{" + strings.Join(s, " ") + "}"
+	case set.OrderedPair:
+		return "(" + format(e.A) + " " + format(e.B) + ")"
+	}
+	return fmt.Sprint(e)
+}
+
+func less(a, b string) bool {
+	x, errA := strconv.ParseFloat(a, 64)
+	y, errB := strconv.ParseFloat(b, 64)
+	switch {
+	case errA == nil && errB == nil && x != y:
+		return x < y
+	case errA == nil && errB != nil:
+		return true
+	case errA != nil && errB == nil:
+		return false
+	}
+	return a < b
+}
@@ -0,0 +1,83 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package main
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update golden files")
+
+// TestScripts runs testdata/*.set and compares output with the
+// corresponding .golden file.
+func TestScripts(t *testing.T) {
+	files, err := filepath.Glob("testdata/*.set")
+	if err != nil || len(files) == 0 {
+		t.Fatal("no test scripts", err)
+	}
+	for _, name := range files {
+		var out bytes.Buffer
+		if err := runFile(newCalc(), name, &out); err != nil {
+			t.Error(err)
+			continue
+		}
+		golden := strings.TrimSuffix(name, ".set") + ".golden"
+		if *update {
+			if err := os.WriteFile(golden, out.Bytes(), 0666); err != nil {
+				t.Fatal(err)
+			}
+			continue
+		}
+		want, err := os.ReadFile(golden)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := out.String(); got != string(want) {
+			t.Errorf("%s: got\n%s\nwant\n%s", name, got, want)
+		}
+	}
+}
+
+func TestErrors(t *testing.T) {
+	c := newCalc()
+	for _, tc := range []struct{ src, want string }{
+		{"{1 2", "1: unclosed {"},
+		{"{1 {2}}}", `8: unexpected '}'`},
+		{"A ∪ {1}", "1: undefined: A"},
+		{"{1} ∪", "6: unexpected end of input"},
+		{"({1}", "5: unexpected end of input"},
+		{"{1} {2}", "5: unexpected {2}"},
+		{"P({1}", "6: unexpected end of input"},
+		{"{1} ? {2}", `5: unexpected '?'`},
+		{"= {1}", "1: unexpected ="},
+	} {
+		_, err := c.line(tc.src)
+		if err == nil || err.Error() != tc.want {
+			t.Errorf("%q: got %v, want %s", tc.src, err, tc.want)
+		}
+	}
+}
+
+func TestScriptError(t *testing.T) {
+	in := strings.NewReader("A = {1}\nA ∪ B\n")
+	err := script(newCalc(), in, &bytes.Buffer{}, "x.set")
+	if err == nil || err.Error() != "x.set:2:5: undefined: B" {
+		t.Fatal(err)
+	}
+}
+
+func TestREPL(t *testing.T) {
+	in := strings.NewReader("A = {1 2}\nA ∪ B\nB = {2 3}; A ∪ B\n")
+	var out bytes.Buffer
+	repl(newCalc(), in, &out)
+	want := "> > error: column 5: undefined: B\n> {1 2 3}\n> \n"
+	if got := out.String(); got != want {
+		t.Fatalf("got %q, want %q", got, want)
+	}
+}
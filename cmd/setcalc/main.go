@@ -0,0 +1,126 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+// Setcalc evaluates set expressions.
+//
+// Usage:
+//
+//	setcalc [-i] [file ...]
+//
+// Setcalc reads statements from the named files, or from standard input if
+// there are none, and prints the value of each expression on its own line.
+// When standard input is a terminal, or with -i, setcalc runs interactively,
+// prompting for each line and reporting errors without stopping.
+//
+// Sets are written in the syntax printed by the set package, with elements
+// separated by spaces or commas:
+//
+//	A = {1 2 3}; B = {3 4}; A ∪ B, A ∩ B, P(A), A × B
+//
+// A line holds statements separated by semicolons.  A statement is either an
+// assignment or a list of expressions separated by commas.  Comments start
+// with # and run to the end of the line.
+//
+// Operators, with ASCII alternatives, from lowest to highest precedence:
+//
+//	∪ |    union
+//	\ -    difference
+//	△ ^    symmetric difference
+//	∩ &    intersection
+//	× *    cartesian product
+//
+// Union, difference, and symmetric difference have the same precedence.
+// P(A) is the power set of A.  Parentheses group.  Elements of results are
+// printed in sorted order and ordered pairs are printed as (a b).
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+func main() {
+	interactive := flag.Bool("i", false, "run interactively")
+	flag.Usage = func() {
+		fmt.Fprintln(flag.CommandLine.Output(), "usage: setcalc [-i] [file ...]")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	c := newCalc()
+	if *interactive || flag.NArg() == 0 && isTerminal(os.Stdin) {
+		for _, name := range flag.Args() {
+			if err := runFile(c, name, io.Discard); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		}
+		repl(c, os.Stdin, os.Stdout)
+		return
+	}
+	if flag.NArg() == 0 {
+		if err := script(c, os.Stdin, os.Stdout, "<stdin>"); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+	for _, name := range flag.Args() {
+		if err := runFile(c, name, os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func isTerminal(f *os.File) bool {
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+func runFile(c *calc, name string, w io.Writer) error {
+	f, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return script(c, f, w, name)
+}
+
+// script evaluates all lines of r, writing values to w.  It stops at the
+// first error, returning it with the name and line number.
+func script(c *calc, r io.Reader, w io.Writer, name string) error {
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		vals, err := c.line(sc.Text())
+		if err != nil {
+			return fmt.Errorf("%s:%d:%v", name, n, err)
+		}
+		for _, v := range vals {
+			fmt.Fprintln(w, format(v))
+		}
+	}
+	return sc.Err()
+}
+
+// repl prompts for lines of r, writing values and errors to w.
+func repl(c *calc, r io.Reader, w io.Writer) {
+	sc := bufio.NewScanner(r)
+	for {
+		fmt.Fprint(w, "> ")
+		if !sc.Scan() {
+			fmt.Fprintln(w)
+			return
+		}
+		vals, err := c.line(sc.Text())
+		if err != nil {
+			fmt.Fprintf(w, "error: column %v\n", err)
+			continue
+		}
+		for _, v := range vals {
+			fmt.Fprintln(w, format(v))
+		}
+	}
+}
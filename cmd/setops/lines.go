@@ -0,0 +1,200 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package main
+
+import (
+	"bufio"
+	"hash/fnv"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/soniakeys/set"
+)
+
+// exact is a line compared byte for byte.
+type exact string
+
+func (l exact) Equal(e set.Element) bool { return l == e }
+
+func (l exact) Hash() uint64 { return hashString(string(l)) }
+
+func (l exact) text() string { return string(l) }
+
+// normalized is a line compared by a normalized key, for the -i and -w
+// options.  The original text is kept for output.
+type normalized struct {
+	orig string
+	key  string
+}
+
+func (l normalized) Equal(e set.Element) bool {
+	m, ok := e.(normalized)
+	return ok && l.key == m.key
+}
+
+func (l normalized) Hash() uint64 { return hashString(l.key) }
+
+func (l normalized) text() string { return l.orig }
+
+// line is implemented by the element types.
+type line interface {
+	set.Hasher
+	text() string
+}
+
+func hashString(s string) uint64 {
+	h := fnv.New64a()
+	io.WriteString(h, s)
+	return h.Sum64()
+}
+
+// fold maps each rune of s to the least rune it is equivalent to under
+// Unicode simple case folding, so that strings.EqualFold(a, b) is true
+// exactly when fold(a) == fold(b).
+func fold(s string) string {
+	return strings.Map(func(r rune) rune {
+		m := r
+		for f := unicode.SimpleFold(r); f != r; f = unicode.SimpleFold(f) {
+			if f < m {
+				m = f
+			}
+		}
+		return m
+	}, s)
+}
+
+// squeeze trims leading and trailing white space and replaces runs of
+// white space with a single space.
+func squeeze(s string) string { return strings.Join(strings.Fields(s), " ") }
+
+// newLine returns a function converting text to an element, comparing
+// case-insensitively if foldCase is true and ignoring differences in white
+// space if squeezeSpace is true.
+func newLine(foldCase, squeezeSpace bool) func(string) line {
+	if !foldCase && !squeezeSpace {
+		return func(s string) line { return exact(s) }
+	}
+	return func(s string) line {
+		k := s
+		if squeezeSpace {
+			k = squeeze(k)
+		}
+		if foldCase {
+			k = fold(k)
+		}
+		return normalized{s, k}
+	}
+}
+
+// lineSet is a set of lines that remembers the order lines were added.
+type lineSet struct {
+	order []line
+	h     set.SetH
+}
+
+func (s *lineSet) add(l line) {
+	if s.h.Add(l) {
+		s.order = append(s.order, l)
+	}
+}
+
+func (s *lineSet) has(l line) bool { return s.h.HasElement(l) }
+
+// readLines reads lines of r into a lineSet.  Of equal lines, the first is
+// kept.
+func readLines(r io.Reader, mk func(string) line) (*lineSet, error) {
+	s := &lineSet{}
+	sc := bufio.NewScanner(r)
+	sc.Buffer(nil, 1<<30)
+	for sc.Scan() {
+		s.add(mk(sc.Text()))
+	}
+	return s, sc.Err()
+}
+
+// union returns lines in any of ss, in order of first appearance.
+func union(ss []*lineSet) *lineSet {
+	r := &lineSet{}
+	for _, s := range ss {
+		for _, l := range s.order {
+			r.add(l)
+		}
+	}
+	return r
+}
+
+// intersect returns lines of ss[0] that are in all of ss.
+func intersect(ss []*lineSet) *lineSet {
+	return filter(ss[0], func(l line) bool {
+		for _, s := range ss[1:] {
+			if !s.has(l) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// difference returns lines of ss[0] that are in none of ss[1:].
+func difference(ss []*lineSet) *lineSet {
+	return filter(ss[0], func(l line) bool {
+		for _, s := range ss[1:] {
+			if s.has(l) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// symmetricDifference returns lines in an odd number of ss.  For two sets,
+// that is lines of ss[0] not in ss[1] followed by lines of ss[1] not in
+// ss[0].
+func symmetricDifference(ss []*lineSet) *lineSet {
+	return filter(union(ss), func(l line) bool {
+		odd := false
+		for _, s := range ss {
+			if s.has(l) {
+				odd = !odd
+			}
+		}
+		return odd
+	})
+}
+
+// subset returns true if each of ss is a subset of the next.
+func subset(ss []*lineSet) bool {
+	for i := 1; i < len(ss); i++ {
+		if len(ss[i-1].order) > len(ss[i].order) {
+			return false
+		}
+		for _, l := range ss[i-1].order {
+			if !ss[i].has(l) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// equal returns true if all of ss are equal.
+func equal(ss []*lineSet) bool {
+	for i := 1; i < len(ss); i++ {
+		if len(ss[i-1].order) != len(ss[i].order) || !subset(ss[i-1:i+1]) {
+			return false
+		}
+	}
+	return true
+}
+
+func filter(s *lineSet, f func(line) bool) *lineSet {
+	r := &lineSet{}
+	for _, l := range s.order {
+		if f(l) {
+			r.add(l)
+		}
+	}
+	return r
+}
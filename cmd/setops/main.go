@@ -0,0 +1,136 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+// Setops performs set operations on files of lines.
+//
+// Usage:
+//
+//	setops [-i] [-w] operation file ...
+//
+// Each file is read as a set of lines.  Of equal lines within a file, only
+// the first is kept.  A file name of - means standard input.
+//
+// Operations printing lines:
+//
+//	union       lines in any file
+//	intersect   lines of the first file that are in all files
+//	diff        lines of the first file that are in no other file
+//	symdiff     lines in an odd number of files
+//
+// Lines are printed in order of first appearance, first from the first
+// file, then from the second, and so on.  When equal lines differ in text
+// because of -i or -w, the first is printed.
+//
+// Operations testing sets, printing nothing:
+//
+//	subset      each file is a subset of the next
+//	equal       all files are equal
+//
+// The exit status is 0 for success or a true test, 1 for a false test, and
+// 2 for errors.
+//
+// Options:
+//
+//	-i  compare lines case-insensitively
+//	-w  ignore leading and trailing white space and treat runs of white
+//	    space as a single space
+//
+// Unlike sort and comm, setops needs no sorted input and preserves the
+// order of lines.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// exit statuses
+const (
+	exitTrue  = 0
+	exitFalse = 1
+	exitError = 2
+)
+
+var ops = map[string]func([]*lineSet) *lineSet{
+	"union":     union,
+	"intersect": intersect,
+	"diff":      difference,
+	"symdiff":   symmetricDifference,
+}
+
+var tests = map[string]func([]*lineSet) bool{
+	"subset": subset,
+	"equal":  equal,
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs setops with command line arguments args, returning the exit
+// status.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("setops", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	foldCase := fs.Bool("i", false, "compare lines case-insensitively")
+	squeezeSpace := fs.Bool("w", false, "ignore differences in white space")
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "usage: setops [-i] [-w] operation file ...")
+		fmt.Fprintln(stderr, "operations: union intersect diff symdiff subset equal")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return exitError
+	}
+	if fs.NArg() < 2 {
+		fs.Usage()
+		return exitError
+	}
+	name := fs.Arg(0)
+	op, test := ops[name], tests[name]
+	if op == nil && test == nil {
+		fmt.Fprintln(stderr, "setops: unknown operation", name)
+		fs.Usage()
+		return exitError
+	}
+	mk := newLine(*foldCase, *squeezeSpace)
+	var ss []*lineSet
+	for _, file := range fs.Args()[1:] {
+		s, err := readFile(file, stdin, mk)
+		if err != nil {
+			fmt.Fprintln(stderr, "setops:", err)
+			return exitError
+		}
+		ss = append(ss, s)
+	}
+	if test != nil {
+		if test(ss) {
+			return exitTrue
+		}
+		return exitFalse
+	}
+	w := bufio.NewWriter(stdout)
+	for _, l := range op(ss).order {
+		fmt.Fprintln(w, l.text())
+	}
+	if err := w.Flush(); err != nil {
+		fmt.Fprintln(stderr, "setops:", err)
+		return exitError
+	}
+	return exitTrue
+}
+
+func readFile(name string, stdin io.Reader, mk func(string) line) (*lineSet, error) {
+	if name == "-" {
+		return readLines(stdin, mk)
+	}
+	f, err := os.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readLines(f, mk)
+}
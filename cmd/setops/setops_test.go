@@ -0,0 +1,67 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	const a, b, c = "testdata/a.txt", "testdata/b.txt", "testdata/c.txt"
+	for _, tc := range []struct {
+		args  []string
+		stdin string
+		out   string
+		code  int
+	}{
+		{[]string{"union", a, b}, "",
+			"apple\nBanana\ncherry\n  date  palm\nbanana\ndate palm\nelderberry\n", 0},
+		{[]string{"-i", "-w", "union", a, b}, "",
+			"apple\nBanana\ncherry\n  date  palm\nelderberry\n", 0},
+		{[]string{"intersect", a, b}, "", "cherry\n", 0},
+		{[]string{"-i", "-w", "intersect", a, b}, "",
+			"Banana\ncherry\n  date  palm\n", 0},
+		{[]string{"diff", a, b}, "", "apple\nBanana\n  date  palm\n", 0},
+		{[]string{"-i", "-w", "diff", a, b, c}, "", "apple\n", 0},
+		{[]string{"symdiff", a, b}, "",
+			"apple\nBanana\n  date  palm\nbanana\ndate palm\nelderberry\n", 0},
+		{[]string{"-i", "symdiff", a, b, c}, "",
+			"apple\ncherry\n  date  palm\ndate palm\nelderberry\nfig\n", 0},
+		{[]string{"diff", "-", a}, "fig\napple\nfig\n", "fig\n", 0},
+		{[]string{"subset", c, b}, "", "", 1},
+		{[]string{"subset", "-", b}, "cherry\n", "", 0},
+		{[]string{"-i", "-w", "equal", a, "-"}, "APPLE\nbanana\ncherry\ndate palm\n", "", 0},
+		{[]string{"equal", a, b}, "", "", 1},
+		{[]string{"equal", a, a}, "", "", 0},
+		{[]string{"union", "testdata/missing"}, "", "", 2},
+		{[]string{"unite", a}, "", "", 2},
+		{[]string{"union"}, "", "", 2},
+		{[]string{"-x", "union", a}, "", "", 2},
+	} {
+		var out, errOut bytes.Buffer
+		code := run(tc.args, strings.NewReader(tc.stdin), &out, &errOut)
+		if code != tc.code || out.String() != tc.out {
+			t.Errorf("%v: exit %d, output %q, want %d, %q (stderr %q)",
+				tc.args, code, out.String(), tc.code, tc.out, errOut.String())
+		}
+	}
+}
+
+func TestFold(t *testing.T) {
+	for _, p := range [][2]string{
+		{"Straße", "STRAßE"},
+		{"k", "K"}, // Kelvin sign
+		{"ſ", "S"}, // long s
+		{"Σίσυφος", "ΣΊΣΥΦΟΣ"},
+	} {
+		if fold(p[0]) != fold(p[1]) {
+			t.Errorf("fold(%q) != fold(%q)", p[0], p[1])
+		}
+	}
+	if fold("a") == fold("b") {
+		t.Error("fold(a) == fold(b)")
+	}
+}
@@ -24,7 +24,7 @@
 //
 // Set type
 //
-// The package defines two set types.  The first, just called Set, has a
+// The package defines several set types.  The first, just called Set, has a
 // minimal number of methods, just to show the basic mathematical concepts
 // represented and how they are implemented with a list of Elements.
 //
@@ -50,5 +50,58 @@
 // like an "ultimate" set API.  It's easy to imagine more and more methods
 // but the API is awfully big as it is.  A number of the methods are trivial.
 //
-// The SetM type is yet untested.
+// The SetM type is only lightly tested.
+//
+// SetH type
+//
+// The SetH type has the methods of SetM but keeps elements in buckets
+// indexed by hash.  Elements may optionally implement the Hasher interface
+// to be spread over buckets.  Equal is still used to decide element equality.
+//
+// SetO type
+//
+// The SetO type is for elements implementing the Ordered interface.  It keeps
+// elements in a sorted slice, using sort.Search as suggested above, and
+// merges sorted slices for operations like Union and Intersect.
+//
+// Type-parameterized sets
+//
+// SetOf[T] is a version of SetM where elements have a static type T with an
+// Equal(T) method, avoiding type assertions on every read.  SetC[T] is a
+// map-based set for Go-comparable T.  Both convert to and from SetM.
+//
+// Other types
+//
+// SyncSet and ShardedSet are safe for concurrent use.  SetP is a persistent
+// set where each modification returns a new version sharing structure with
+// the old.
+//
+// Relation, Function, and Poset build on sets of OrderedPair.  Bag and
+// FuzzySet generalize membership to multiplicities and degrees.
+//
+// Encoding
+//
+// Set and SetM implement json.Marshaler and json.Unmarshaler.  Since elements
+// are interfaces, each element is tagged with the name its type was
+// registered under with RegisterElement.
+//
+// Set and SetM also implement encoding.BinaryMarshaler and gob.GobEncoder
+// with a compact format described in binary.go.  Element types are
+// registered for it with RegisterBinary, analogous to gob.Register.
+//
+// Parser parses the set literal syntax printed by String, such as
+// {1 {2 3} {}}, back into a Set or SetM.
+//
+// Output and randomness
+//
+// String and methods such as Do, Peek, and Pop visit elements in random
+// order.  Canonical formats sets with elements sorted, for output that can
+// be compared.  SetRandSource makes the random order reproducible.
+//
+// Set and SetM implement fmt.Formatter.  With the verb %v, the + flag
+// selects canonical output, the # flag Go syntax, and a width a multi-line
+// layout with nested sets indented.
+//
+// LaTeX and MathML render sets, relations, and ordered pairs as markup for
+// publishing.
 package set
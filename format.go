@@ -0,0 +1,296 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Canonical returns a canonical string representation of e.
+//
+// Sets are formatted with the same syntax as their String methods, but with
+// elements in a deterministic order.  This includes Set, SetM, Relation,
+// Function, Bag, FuzzySet, and the set types with a SetM method, SetH, SetO,
+// and SetP.  If all elements of a set implement Ordered, they
+// are sorted with Less.  Otherwise they are sorted by their canonical
+// representations.  Nested sets, including sets nested in OrderedPair and
+// Tuple values, are formatted canonically as well.  Other elements are
+// formatted with fmt.Sprint.
+//
+// Equal sets of elements with deterministic formatting have equal canonical
+// representations, so Canonical is useful for golden-file tests and for
+// comparing output.
+func Canonical(e Element) string {
+	switch e := e.(type) {
+	case Set:
+		return canonicalSet(e)
+	case SetM:
+		return canonicalSet(e)
+	case Relation:
+		return canonicalSet(e)
+	case Function:
+		return canonicalSet(e)
+	case Bag:
+		es := make([]Element, len(e))
+		for i, be := range e {
+			es[i] = be.E
+		}
+		return canonicalList(es, func(i int, c string) string {
+			return strings.TrimSuffix(strings.Repeat(c+" ", e[i].N), " ")
+		})
+	case FuzzySet:
+		es := make([]Element, len(e))
+		for i, m := range e {
+			es[i] = m.E
+		}
+		return canonicalList(es, func(i int, c string) string {
+			return fmt.Sprintf("%s/%g", c, e[i].Mu)
+		})
+	case interface{ SetM() SetM }:
+		return canonicalSet(e.SetM())
+	case OrderedPair:
+		return "{" + Canonical(e.A) + " " + Canonical(e.B) + "}"
+	case Tuple:
+		c := make([]string, len(e))
+		for i, x := range e {
+			c[i] = Canonical(x)
+		}
+		return "[" + strings.Join(c, " ") + "]"
+	}
+	return fmt.Sprint(e)
+}
+
+// Canonical returns a canonical string representation of s.
+//
+// See the package function Canonical.
+func (s Set) Canonical() string { return canonicalSet(s) }
+
+// Canonical returns a canonical string representation of s.
+//
+// See the package function Canonical.
+func (s SetM) Canonical() string { return canonicalSet(s) }
+
+func canonicalSet(s []Element) string {
+	return canonicalList(s, func(_ int, c string) string { return c })
+}
+
+// canonicalList formats elements of s in canonical order.  Element i with
+// canonical representation c is printed as f(i, c).
+func canonicalList(s []Element, f func(i int, c string) string) string {
+	c := make([]string, len(s))
+	for i, e := range s {
+		c[i] = Canonical(e)
+	}
+	r := make([]string, 0, len(s))
+	for _, j := range canonicalPerm(s, c) {
+		if x := f(j, c[j]); x != "" {
+			r = append(r, x)
+		}
+	}
+	return "{" + strings.Join(r, " ") + "}"
+}
+
+// canonicalPerm returns the indexes of s in canonical order, given the
+// canonical representations c of the elements.
+func canonicalPerm(s []Element, c []string) []int {
+	x := make([]int, len(s))
+	for i := range x {
+		x[i] = i
+	}
+	ordered := true
+	for _, e := range s {
+		if _, ok := e.(Ordered); !ok {
+			ordered = false
+			break
+		}
+	}
+	if ordered {
+		sort.SliceStable(x, func(i, j int) bool {
+			return s[x[i]].(Ordered).Less(s[x[j]])
+		})
+	} else {
+		sort.SliceStable(x, func(i, j int) bool { return c[x[i]] < c[x[j]] })
+	}
+	return x
+}
+
+// Format satisfies fmt.Formatter.
+//
+// The verb %v prints the same as String.  Flags and width select other
+// representations:
+//
+//   %+v  canonical representation, as returned by Canonical
+//   %#v  Go syntax, for example set.Set{intEle(1), set.Set{}}
+//   %4v  multi-line representation with nested sets indented 4 spaces
+//
+// A width may be combined with the + flag for a sorted multi-line
+// representation.  In the multi-line representation, sets without nested
+// sets are printed on a single line.
+//
+// In Go syntax, types of package set are qualified with "set.".  Other
+// element types are written without a package qualifier, as they would be
+// written in their own package.
+//
+// The verbs %s, %q, %x, and %X format the result of String with the usual
+// flags, width, and precision.
+func (s Set) Format(f fmt.State, verb rune) { formatSet(f, verb, s, s) }
+
+// Format satisfies fmt.Formatter.
+//
+// See Set.Format for the supported verbs and flags.
+func (s SetM) Format(f fmt.State, verb rune) { formatSet(f, verb, s, s) }
+
+// formatSet implements Format for set e with elements s.
+func formatSet(f fmt.State, verb rune, e Element, s []Element) {
+	str := e.(fmt.Stringer).String
+	switch verb {
+	case 'v':
+	case 's', 'q', 'x', 'X':
+		fmt.Fprintf(f, fmt.FormatString(f, verb), str())
+		return
+	default:
+		fmt.Fprintf(f, "%%!%c(%T=%s)", verb, e, str())
+		return
+	}
+	sorted := f.Flag('+')
+	switch w, ok := f.Width(); {
+	case f.Flag('#'):
+		io.WriteString(f, goSyntax(e))
+	case ok:
+		var b strings.Builder
+		writeIndented(&b, s, sorted, strings.Repeat(" ", w), "")
+		io.WriteString(f, b.String())
+	case sorted:
+		io.WriteString(f, canonicalSet(s))
+	default:
+		io.WriteString(f, str())
+	}
+}
+
+// nestedSet returns the elements of e if e is a Set or SetM.
+func nestedSet(e Element) ([]Element, bool) {
+	switch e := e.(type) {
+	case Set:
+		return e, true
+	case SetM:
+		return e, true
+	}
+	return nil, false
+}
+
+// writeIndented writes s to b, one element per line if s contains nested
+// sets.  The opening brace is assumed to be already indented; elements are
+// indented by prefix+indent and the closing brace by prefix.
+func writeIndented(b *strings.Builder, s []Element, sorted bool, indent, prefix string) {
+	flat := true
+	for _, e := range s {
+		if _, ok := nestedSet(e); ok {
+			flat = false
+			break
+		}
+	}
+	if flat {
+		if sorted {
+			b.WriteString(canonicalSet(s))
+		} else {
+			b.WriteString(SetM(s).String())
+		}
+		return
+	}
+	var order []int
+	if sorted {
+		c := make([]string, len(s))
+		for i, e := range s {
+			c[i] = Canonical(e)
+		}
+		order = canonicalPerm(s, c)
+	} else {
+		order = randPerm(len(s))
+	}
+	b.WriteString("{\n")
+	for _, i := range order {
+		b.WriteString(prefix + indent)
+		switch sub, ok := nestedSet(s[i]); {
+		case ok:
+			writeIndented(b, sub, sorted, indent, prefix+indent)
+		case sorted:
+			b.WriteString(Canonical(s[i]))
+		default:
+			b.WriteString(fmt.Sprint(s[i]))
+		}
+		b.WriteString("\n")
+	}
+	b.WriteString(prefix + "}")
+}
+
+// goSyntax returns a Go syntax representation of e.
+func goSyntax(e Element) string {
+	switch x := e.(type) {
+	case nil:
+		return "nil"
+	case Set:
+		return goSyntaxList(e, x)
+	case SetM:
+		return goSyntaxList(e, x)
+	case Tuple:
+		return goSyntaxList(e, x)
+	case OrderedPair:
+		return fmt.Sprintf("%s{A: %s, B: %s}",
+			goTypeName(reflect.TypeOf(x)), goSyntax(x.A), goSyntax(x.B))
+	case fmt.GoStringer:
+		return x.GoString()
+	}
+	t := reflect.TypeOf(e)
+	v := fmt.Sprintf("%#v", e)
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16,
+		reflect.Int32, reflect.Int64, reflect.Uint, reflect.Uint8,
+		reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64,
+		reflect.Complex128, reflect.String:
+		// %#v omits the type of basic values; a conversion restores it
+		return goTypeName(t) + "(" + v + ")"
+	case reflect.Pointer:
+		// %#v writes &T{...} for pointers to structs
+		if r, ok := strings.CutPrefix(v, "&"+t.Elem().String()); ok {
+			return "&" + goTypeName(t.Elem()) + r
+		}
+	}
+	if r, ok := strings.CutPrefix(v, t.String()); ok {
+		return goTypeName(t) + r
+	}
+	return v
+}
+
+// setPkgPath is the import path of this package.
+var setPkgPath = reflect.TypeFor[Set]().PkgPath()
+
+// goTypeName returns the name of t as written by goSyntax.
+func goTypeName(t reflect.Type) string {
+	switch {
+	case t.Kind() == reflect.Pointer && t.Name() == "":
+		return "*" + goTypeName(t.Elem())
+	case t.Name() == "":
+		return t.String()
+	case t.PkgPath() == setPkgPath:
+		return "set." + t.Name()
+	}
+	return t.Name()
+}
+
+func goSyntaxList(e Element, s []Element) string {
+	n := goTypeName(reflect.TypeOf(e))
+	if s == nil {
+		return n + "(nil)"
+	}
+	c := make([]string, len(s))
+	for i, x := range s {
+		c[i] = goSyntax(x)
+	}
+	return n + "{" + strings.Join(c, ", ") + "}"
+}
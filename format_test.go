@@ -0,0 +1,120 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func TestCanonical(t *testing.T) {
+	s := set.SetM{
+		intEle(10),
+		intEle(9),
+		set.SetM{intEle(3), intEle(2)},
+		set.SetM{},
+		set.OrderedPair{set.Set{intEle(2), intEle(1)}, intEle(0)},
+	}
+	want := "{10 9 {2 3} {{1 2} 0} {}}"
+	for i := 0; i < 10; i++ {
+		p := s.Copy()
+		rand.Shuffle(len(p), func(i, j int) { p[i], p[j] = p[j], p[i] })
+		if got := p.Canonical(); got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+	// Ordered elements sort by Less
+	if got := (set.Set{oEle(10), oEle(9), oEle(100)}).Canonical(); got != "{9 10 100}" {
+		t.Fatal("Ordered:", got)
+	}
+	if got := set.Canonical(set.Tuple{set.SetM{oEle(2), oEle(1)}, oEle(1)}); got != "[{1 2} 1]" {
+		t.Fatal("Tuple:", got)
+	}
+}
+
+func TestCanonicalTypes(t *testing.T) {
+	f := set.Tabulate(ints(1, 2, 3), func(e set.Element) set.Element { return e.(intEle) * 2 })
+	s := set.SetM{
+		rel([2]int{3, 1}, [2]int{1, 2}, [2]int{2, 3}, [2]int{1, 1}),
+		f,
+		set.NewBag(ints(2, 1, 2, 3, 3, 3)...),
+		set.FuzzySet{{intEle(2), .5}, {intEle(1), 1}, {intEle(3), .25}},
+		set.NewSetH(hEle(9), hEle(2), hEle(16)),
+	}
+	want := "{{1 2 2 3 3 3} {1/1 2/0.5 3/0.25} {16 2 9} " +
+		"{{1 1} {1 2} {2 3} {3 1}} {{1 2} {2 4} {3 6}}}"
+	for i := 0; i < 50; i++ {
+		if got := s.Canonical(); got != want {
+			t.Fatalf("got  %s\nwant %s", got, want)
+		}
+	}
+}
+
+func TestSetRandSource(t *testing.T) {
+	defer set.SetRandSource(nil)
+	s := set.SetM{intEle(1), intEle(2), intEle(3), intEle(4), intEle(5)}
+	run := func() (r []any) {
+		set.SetRandSource(rand.NewSource(7))
+		r = append(r, s.String())
+		e, _ := s.Peek()
+		r = append(r, e)
+		p := s.Copy()
+		e, _ = p.Pop()
+		r = append(r, e)
+		next := s.IterFunc()
+		for e, ok := next(); ok; e, ok = next() {
+			r = append(r, e)
+		}
+		return
+	}
+	a, b := run(), run()
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatal("not reproducible:", a, b)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	defer set.SetRandSource(nil)
+	s := set.SetM{
+		intEle(10),
+		set.SetM{intEle(3), set.Set{}},
+		set.OrderedPair{intEle(1), fEle(.5)},
+	}
+	set.SetRandSource(rand.NewSource(1))
+	str := s.String()
+	set.SetRandSource(rand.NewSource(1))
+	if got := fmt.Sprintf("%v", s); got != str {
+		t.Fatalf("v verb: got %s, want %s", got, str)
+	}
+	for _, tc := range []struct {
+		format string
+		s      set.Element
+		want   string
+	}{
+		{"%#v", s, "set.SetM{intEle(10), set.SetM{intEle(3), set.Set{}}, " +
+			"set.OrderedPair{A: intEle(1), B: fEle(0.5)}}"},
+		{"%#v", set.Set(nil), "set.Set(nil)"},
+		{"%#v", set.Set{point{1, 2}, &point{3, 4}, set.Tuple{name("x")}},
+			`set.Set{point{x:0x1, y:0x2}, &point{x:0x3, y:0x4}, set.Tuple{name("x")}}`},
+		{"%+v", s, "{10 {1 0.5} {3 {}}}"},
+		{"%+2v", s, "{\n  10\n  {1 0.5}\n  {\n    3\n    {}\n  }\n}"},
+		{"%+4v", set.Set{intEle(2), intEle(1)}, "{1 2}"},
+		{"%s", set.Set{intEle(1)}, "{1}"},
+		{"%-6s|", set.Set{intEle(1)}, "{1}   |"},
+		{"%6s", set.SetM{intEle(1)}, "   {1}"},
+		{"%q", set.SetM{intEle(1)}, `"{1}"`},
+		{"%x", set.Set{intEle(1)}, "7b317d"},
+		{"%X", set.Set{intEle(1)}, "7B317D"},
+		{"%d", set.Set{intEle(1)}, "%!d(set.Set={1})"},
+	} {
+		if got := fmt.Sprintf(tc.format, tc.s); got != tc.want {
+			t.Errorf("%s: got\n%s\nwant\n%s", tc.format, got, tc.want)
+		}
+	}
+}
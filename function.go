@@ -0,0 +1,126 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+// Function is a finite function, represented as a set of ordered pairs.
+//
+// Function has the underlying type of Relation and all rules for Relation
+// apply.  Additionally a Function must be single-valued:  for any x there
+// must be at most one pair (x, y).  Ok validates this.
+//
+// A pair (x, y) in the function means the function maps x to y.
+type Function Relation
+
+// Tabulate returns the function mapping each x in dom to f(x).
+func Tabulate(dom SetM, f func(Element) Element) Function {
+	fn := make(Function, len(dom))
+	for i, x := range dom {
+		fn[i] = OrderedPair{x, f(x)}
+	}
+	return fn
+}
+
+// Ok validates that f is a valid Relation and is single-valued.
+func (f Function) Ok() bool {
+	if !Relation(f).Ok() {
+		return false
+	}
+	for i, e := range f {
+		x := e.(OrderedPair).A
+		for _, d := range f[:i] {
+			if x.Equal(d.(OrderedPair).A) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Apply returns the value f maps x to.
+//
+// If x is not in the domain of f, Apply returns nil, false.
+func (f Function) Apply(x Element) (Element, bool) {
+	for _, e := range f {
+		if p := e.(OrderedPair); x.Equal(p.A) {
+			return p.B, true
+		}
+	}
+	return nil, false
+}
+
+// Compose returns the composition of f followed by g.
+//
+// The result maps x to g(f(x)) for each x in the domain of f where f(x) is in
+// the domain of g.  In the usual mathematical notation this is g ∘ f.
+func (f Function) Compose(g Function) (c Function) {
+	for _, e := range f {
+		p := e.(OrderedPair)
+		if y, ok := g.Apply(p.B); ok {
+			c = append(c, OrderedPair{p.A, y})
+		}
+	}
+	return
+}
+
+// Domain returns the set of elements f maps from.
+func (f Function) Domain() SetM { return Relation(f).Domain() }
+
+// Equal satisfies the Element interface, allowing Function values to be
+// elements of sets.
+//
+// If the dynamic type of the argument is not Function or if the functions
+// do not contain the same pairs, Equal returns false.
+func (f Function) Equal(e Element) bool {
+	g, ok := e.(Function)
+	return ok && SetM(f).Equal(SetM(g))
+}
+
+// Image returns the set of values f maps elements of x to.
+func (f Function) Image(x SetM) SetM { return Relation(f).Image(x) }
+
+// Inverse returns the inverse of f, a function from cod to dom.
+//
+// If f is not a bijection from dom to cod, Inverse returns nil, false.
+func (f Function) Inverse(dom, cod SetM) (Function, bool) {
+	if !f.IsBijective(dom, cod) {
+		return nil, false
+	}
+	return Function(Relation(f).Inverse()), true
+}
+
+// IsBijective returns true if f is a bijection from dom to cod.
+//
+// That is, f is a function from dom to cod that is both injective and
+// surjective onto cod.
+func (f Function) IsBijective(dom, cod SetM) bool {
+	return f.IsFunction(dom, cod) && f.IsInjective() && f.IsSurjective(cod)
+}
+
+// IsFunction returns true if f is a function from dom to cod.
+//
+// That is, the domain of f is exactly dom and f maps each element of dom
+// to an element of cod.
+func (f Function) IsFunction(dom, cod SetM) bool {
+	return f.Domain().Equal(dom) && cod.HasAll(f.Range()...)
+}
+
+// IsInjective returns true if f maps distinct elements to distinct values.
+func (f Function) IsInjective() bool {
+	return len(f.Range()) == len(f)
+}
+
+// IsSurjective returns true if every element of cod is a value of f.
+func (f Function) IsSurjective(cod SetM) bool {
+	return f.Range().HasAll(cod...)
+}
+
+// Preimage returns the set of elements f maps to values in y.
+func (f Function) Preimage(y SetM) SetM { return Relation(f).Preimage(y) }
+
+// Range returns the set of values of f.
+func (f Function) Range() SetM { return Relation(f).Range() }
+
+// String satisfies fmt.Stringer, providing a printable representation of a
+// function.
+func (f Function) String() string { return SetM(f).String() }
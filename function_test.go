@@ -0,0 +1,70 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func TestFunction(t *testing.T) {
+	dom := ints(1, 2, 3, 4)
+	sq := set.Tabulate(dom, func(e set.Element) set.Element { return e.(intEle) * e.(intEle) })
+	if !sq.Ok() {
+		t.Fatal("Ok")
+	}
+	if y, ok := sq.Apply(intEle(3)); !ok || y != intEle(9) {
+		t.Fatal("Apply:", y)
+	}
+	if _, ok := sq.Apply(intEle(5)); ok {
+		t.Fatal("Apply outside domain")
+	}
+	if y := sq.Image(ints(1, 2)); !y.Equal(ints(1, 4)) {
+		t.Fatal("Image:", y)
+	}
+	if x := sq.Preimage(ints(4, 9, 10)); !x.Equal(ints(2, 3)) {
+		t.Fatal("Preimage:", x)
+	}
+	cod := ints(1, 4, 9, 16)
+	if !sq.IsFunction(dom, cod) || !sq.IsBijective(dom, cod) {
+		t.Fatal("bijective")
+	}
+	inv, ok := sq.Inverse(dom, cod)
+	if !ok || !inv.Compose(sq).Equal(set.Tabulate(cod, func(e set.Element) set.Element { return e })) {
+		t.Fatal("Inverse:", inv)
+	}
+	if sq.IsSurjective(ints(1, 2, 4)) || sq.IsFunction(dom, ints(1, 4)) {
+		t.Fatal("not onto, not into")
+	}
+	if c := sq.Compose(sq); !c.Equal(set.Function{
+		set.OrderedPair{intEle(1), intEle(1)},
+		set.OrderedPair{intEle(2), intEle(16)},
+	}) {
+		t.Fatal("Compose:", c)
+	}
+}
+
+func TestFunctionNotInjective(t *testing.T) {
+	dom := ints(-1, 0, 1)
+	abs := set.Tabulate(dom, func(e set.Element) set.Element {
+		if x := e.(intEle); x < 0 {
+			return -x
+		}
+		return e
+	})
+	if abs.IsInjective() || !abs.IsSurjective(ints(0, 1)) {
+		t.Fatal("abs")
+	}
+	if _, ok := abs.Inverse(dom, ints(0, 1)); ok {
+		t.Fatal("Inverse of non-injective")
+	}
+	bad := set.Function{
+		set.OrderedPair{intEle(1), intEle(1)},
+		set.OrderedPair{intEle(1), intEle(2)},
+	}
+	if bad.Ok() {
+		t.Fatal("multi-valued function Ok")
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"fmt"
+	"math"
+)
+
+// Membership is an element of a FuzzySet together with its degree of
+// membership.
+type Membership struct {
+	E  Element
+	Mu float64
+}
+
+// FuzzySet is a set where elements have a degree of membership in [0, 1].
+//
+// A FuzzySet is a slice of Memberships.  An element not in the slice has
+// membership 0.  Elements with membership 0 are not kept in the slice.
+// As with SetM, order is irrelevant and Equal must return false for the
+// elements of any pair of Memberships.  Ok validates these rules.
+type FuzzySet []Membership
+
+// A TNorm combines two membership degrees.
+//
+// T-norms such as MinTNorm generalize intersection.  T-conorms such as
+// MaxTConorm generalize union.  Both have the same function type.
+type TNorm func(a, b float64) float64
+
+// MinTNorm is the standard, or Gödel, t-norm.
+func MinTNorm(a, b float64) float64 { return math.Min(a, b) }
+
+// ProductTNorm is the product t-norm.
+func ProductTNorm(a, b float64) float64 { return a * b }
+
+// LukasiewiczTNorm is the Łukasiewicz t-norm.
+func LukasiewiczTNorm(a, b float64) float64 { return math.Max(0, a+b-1) }
+
+// MaxTConorm is the standard t-conorm, dual to MinTNorm.
+func MaxTConorm(a, b float64) float64 { return math.Max(a, b) }
+
+// ProbSumTConorm is the probabilistic sum, dual to ProductTNorm.
+func ProbSumTConorm(a, b float64) float64 { return a + b - a*b }
+
+// BoundedSumTConorm is the bounded sum, dual to LukasiewiczTNorm.
+func BoundedSumTConorm(a, b float64) float64 { return math.Min(1, a+b) }
+
+// Ok validates that membership degrees are in (0, 1] and that Equal returns
+// false for all pairs of elements.
+func (f FuzzySet) Ok() bool {
+	for i, m := range f {
+		if !(m.Mu > 0 && m.Mu <= 1) {
+			return false
+		}
+		for _, n := range f[:i] {
+			if m.E.Equal(n.E) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// index returns the index of the Membership for e, or -1.
+func (f FuzzySet) index(e Element) int {
+	for i, m := range f {
+		if e.Equal(m.E) {
+			return i
+		}
+	}
+	return -1
+}
+
+// AlphaCut returns the set of elements with membership at least alpha.
+func (f FuzzySet) AlphaCut(alpha float64) (s SetM) {
+	for _, m := range f {
+		if m.Mu >= alpha {
+			s = append(s, m.E)
+		}
+	}
+	return
+}
+
+// Complement returns the complement of f relative to universe.
+//
+// Each element of universe has membership 1 minus its membership in f.
+// Elements of f not in universe are not in the result.
+func (f FuzzySet) Complement(universe SetM) FuzzySet {
+	c := FuzzySet{}
+	for _, e := range universe {
+		c.SetMu(e, 1-f.Mu(e))
+	}
+	return c
+}
+
+// Core returns the set of elements with membership 1.
+func (f FuzzySet) Core() SetM { return f.AlphaCut(1) }
+
+// Equal satisfies the Element interface, allowing FuzzySet values to be
+// elements of sets.
+//
+// Fuzzy sets are equal if they have the same elements with the same degrees
+// of membership.  If the dynamic type of the argument is not FuzzySet, Equal
+// returns false.
+func (f FuzzySet) Equal(e Element) bool {
+	g, ok := e.(FuzzySet)
+	if !ok || len(f) != len(g) {
+		return false
+	}
+	for _, m := range f {
+		if g.Mu(m.E) != m.Mu {
+			return false
+		}
+	}
+	return true
+}
+
+// Height returns the greatest degree of membership of any element, or 0 for
+// an empty fuzzy set.
+func (f FuzzySet) Height() (h float64) {
+	for _, m := range f {
+		h = math.Max(h, m.Mu)
+	}
+	return
+}
+
+// Intersect returns the standard intersection of f and g, using MinTNorm.
+func (f FuzzySet) Intersect(g FuzzySet) FuzzySet {
+	return f.IntersectT(g, MinTNorm)
+}
+
+// IntersectT returns the intersection of f and g under t-norm t.
+func (f FuzzySet) IntersectT(g FuzzySet, t TNorm) FuzzySet {
+	i := FuzzySet{}
+	for _, m := range f {
+		i.SetMu(m.E, t(m.Mu, g.Mu(m.E)))
+	}
+	return i
+}
+
+// Mu returns the degree of membership of e in f.
+func (f FuzzySet) Mu(e Element) float64 {
+	if i := f.index(e); i >= 0 {
+		return f[i].Mu
+	}
+	return 0
+}
+
+// SetMu sets the degree of membership of e in f.
+//
+// Mu is clamped to [0, 1].  A degree of 0 removes e from the slice.
+func (r *FuzzySet) SetMu(e Element, mu float64) {
+	mu = math.Max(0, math.Min(1, mu))
+	f := *r
+	i := f.index(e)
+	switch {
+	case i < 0 && mu > 0:
+		// always allocate new backing array, as with SetM.Add
+		*r = append(f[:len(f):len(f)], Membership{e, mu})
+	case i >= 0 && mu > 0:
+		f = append(FuzzySet{}, f...)
+		f[i].Mu = mu
+		*r = f
+	case i >= 0:
+		f = append(f[:i:i], f[i+1:]...)
+		*r = f
+	}
+}
+
+// StrongAlphaCut returns the set of elements with membership greater than
+// alpha.
+func (f FuzzySet) StrongAlphaCut(alpha float64) (s SetM) {
+	for _, m := range f {
+		if m.Mu > alpha {
+			s = append(s, m.E)
+		}
+	}
+	return
+}
+
+// String satisfies fmt.Stringer, providing a printable representation of a
+// fuzzy set.
+//
+// Each element is printed followed by a slash and its degree of membership.
+func (f FuzzySet) String() string {
+	r := "{"
+	for i, j := range randPerm(len(f)) {
+		if i > 0 {
+			r += " "
+		}
+		r += fmt.Sprintf("%v/%g", f[j].E, f[j].Mu)
+	}
+	return r + "}"
+}
+
+// Support returns the set of elements with nonzero membership.
+//
+// As elements with membership 0 are not kept, these are all elements of f.
+func (f FuzzySet) Support() SetM {
+	s := make(SetM, len(f))
+	for i, m := range f {
+		s[i] = m.E
+	}
+	return s
+}
+
+// Union returns the standard union of f and g, using MaxTConorm.
+func (f FuzzySet) Union(g FuzzySet) FuzzySet {
+	return f.UnionS(g, MaxTConorm)
+}
+
+// UnionS returns the union of f and g under t-conorm s.
+func (f FuzzySet) UnionS(g FuzzySet, s TNorm) FuzzySet {
+	u := FuzzySet{}
+	for _, m := range f {
+		u.SetMu(m.E, s(m.Mu, g.Mu(m.E)))
+	}
+	for _, m := range g {
+		if f.index(m.E) < 0 {
+			u.SetMu(m.E, s(0, m.Mu))
+		}
+	}
+	return u
+}
@@ -0,0 +1,62 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func TestFuzzySet(t *testing.T) {
+	var f set.FuzzySet
+	f.SetMu(intEle(1), 1)
+	f.SetMu(intEle(2), .5)
+	f.SetMu(intEle(3), .2)
+	f.SetMu(intEle(4), 1.5) // clamped
+	f.SetMu(intEle(4), 0)   // removed
+	if !f.Ok() || len(f) != 3 || f.Mu(intEle(2)) != .5 || f.Mu(intEle(4)) != 0 {
+		t.Fatal("SetMu:", f)
+	}
+	if c := f.AlphaCut(.5); !c.Equal(ints(1, 2)) {
+		t.Fatal("AlphaCut:", c)
+	}
+	if c := f.StrongAlphaCut(.5); !c.Equal(ints(1)) {
+		t.Fatal("StrongAlphaCut:", c)
+	}
+	if !f.Core().Equal(ints(1)) || !f.Support().Equal(ints(1, 2, 3)) || f.Height() != 1 {
+		t.Fatal("Core, Support, Height")
+	}
+	var g set.FuzzySet
+	g.SetMu(intEle(2), .8)
+	g.SetMu(intEle(5), .4)
+	u := f.Union(g)
+	if !u.Ok() || u.Mu(intEle(2)) != .8 || u.Mu(intEle(5)) != .4 || len(u) != 4 {
+		t.Fatal("Union:", u)
+	}
+	i := f.Intersect(g)
+	if !i.Ok() || len(i) != 1 || i.Mu(intEle(2)) != .5 {
+		t.Fatal("Intersect:", i)
+	}
+	if p := f.IntersectT(g, set.ProductTNorm); p.Mu(intEle(2)) != .4 {
+		t.Fatal("IntersectT:", p)
+	}
+	if l := f.IntersectT(g, set.LukasiewiczTNorm); math.Abs(l.Mu(intEle(2))-.3) > 1e-12 {
+		t.Fatal("Lukasiewicz:", l)
+	}
+	if s := f.UnionS(g, set.ProbSumTConorm); s.Mu(intEle(2)) != .9 {
+		t.Fatal("UnionS:", s)
+	}
+	if s := f.UnionS(g, set.BoundedSumTConorm); s.Mu(intEle(2)) != 1 {
+		t.Fatal("BoundedSum:", s)
+	}
+	c := f.Complement(ints(1, 2, 3, 4))
+	if !c.Ok() || c.Mu(intEle(1)) != 0 || c.Mu(intEle(2)) != .5 || c.Mu(intEle(4)) != 1 {
+		t.Fatal("Complement:", c)
+	}
+	if !f.Equal(f.Union(nil)) || f.Equal(g) {
+		t.Fatal("Equal")
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sync"
+)
+
+// JSON encoding
+//
+// Set and SetM encode as JSON arrays.  Each element is encoded as an object
+// with a type tag and a value:
+//
+//   [{"type":"int","value":1},{"type":"SetM","value":[]}]
+//
+// The type tag is the name the element's dynamic type was registered with.
+// Types must be registered with RegisterElement before they can be encoded
+// or decoded.  Set, SetM, OrderedPair, and Tuple are registered by the
+// package under their own names.
+
+var jsonReg = struct {
+	sync.RWMutex
+	decoders map[string]func([]byte) (Element, error)
+	names    map[reflect.Type]string
+}{
+	decoders: map[string]func([]byte) (Element, error){},
+	names:    map[reflect.Type]string{},
+}
+
+// RegisterElement registers element type T under name for JSON encoding.
+//
+// Values of T are encoded with encoding/json and tagged with name.  When
+// decoding, values tagged with name are decoded with decode.  If decode is
+// nil, values are decoded with json.Unmarshal into a value of type T.
+//
+// RegisterElement panics if name or T is already registered.
+func RegisterElement[T Element](name string, decode func(data []byte) (T, error)) {
+	t := reflect.TypeFor[T]()
+	if decode == nil {
+		decode = func(data []byte) (v T, err error) {
+			err = json.Unmarshal(data, &v)
+			return
+		}
+	}
+	jsonReg.Lock()
+	defer jsonReg.Unlock()
+	if _, ok := jsonReg.decoders[name]; ok {
+		panic("set: duplicate registration of element name " + name)
+	}
+	if _, ok := jsonReg.names[t]; ok {
+		panic("set: duplicate registration of element type " + t.String())
+	}
+	jsonReg.decoders[name] = func(data []byte) (Element, error) {
+		return decode(data)
+	}
+	jsonReg.names[t] = name
+}
+
+func init() {
+	RegisterElement("Set", func(data []byte) (s Set, err error) {
+		err = s.UnmarshalJSON(data)
+		return
+	})
+	RegisterElement("SetM", func(data []byte) (s SetM, err error) {
+		err = s.UnmarshalJSON(data)
+		return
+	})
+	RegisterElement("OrderedPair", func(data []byte) (p OrderedPair, err error) {
+		err = p.UnmarshalJSON(data)
+		return
+	})
+	RegisterElement("Tuple", func(data []byte) (t Tuple, err error) {
+		err = t.UnmarshalJSON(data)
+		return
+	})
+}
+
+// taggedElement is the JSON encoding of a single element.
+type taggedElement struct {
+	Type  string          `json:"type"`
+	Value json.RawMessage `json:"value"`
+}
+
+func marshalElement(e Element) (taggedElement, error) {
+	jsonReg.RLock()
+	name, ok := jsonReg.names[reflect.TypeOf(e)]
+	jsonReg.RUnlock()
+	if !ok {
+		return taggedElement{}, fmt.Errorf("set: unregistered element type %T", e)
+	}
+	v, err := json.Marshal(e)
+	return taggedElement{name, v}, err
+}
+
+func unmarshalElement(t taggedElement) (Element, error) {
+	jsonReg.RLock()
+	decode, ok := jsonReg.decoders[t.Type]
+	jsonReg.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("set: unregistered element name %q", t.Type)
+	}
+	return decode(t.Value)
+}
+
+func marshalElements(es []Element) ([]byte, error) {
+	ts := make([]taggedElement, len(es))
+	for i, e := range es {
+		var err error
+		if ts[i], err = marshalElement(e); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(ts)
+}
+
+func unmarshalElements(data []byte) ([]Element, error) {
+	var ts []taggedElement
+	if err := json.Unmarshal(data, &ts); err != nil {
+		return nil, err
+	}
+	es := make([]Element, len(ts))
+	for i, t := range ts {
+		var err error
+		if es[i], err = unmarshalElement(t); err != nil {
+			return nil, err
+		}
+	}
+	return es, nil
+}
+
+// errDuplicate is returned when decoded input violates the rule that
+// Equal return false for all pairs of elements.
+var errDuplicate = errors.New("set: duplicate elements")
+
+// MarshalJSON satisfies json.Marshaler.
+func (s Set) MarshalJSON() ([]byte, error) { return marshalElements(s) }
+
+// UnmarshalJSON satisfies json.Unmarshaler.
+//
+// It returns an error if the decoded set is not Ok, that is, if it contains
+// duplicate elements.
+func (p *Set) UnmarshalJSON(data []byte) error {
+	es, err := unmarshalElements(data)
+	if err != nil {
+		return err
+	}
+	if s := Set(es); !s.Ok() {
+		return errDuplicate
+	}
+	*p = es
+	return nil
+}
+
+// MarshalJSON satisfies json.Marshaler.
+func (s SetM) MarshalJSON() ([]byte, error) { return marshalElements(s) }
+
+// UnmarshalJSON satisfies json.Unmarshaler.
+//
+// It returns an error if the decoded set is not Ok, that is, if it contains
+// duplicate elements.
+func (r *SetM) UnmarshalJSON(data []byte) error {
+	es, err := unmarshalElements(data)
+	if err != nil {
+		return err
+	}
+	if s := SetM(es); !s.Ok() {
+		return errDuplicate
+	}
+	*r = es
+	return nil
+}
+
+// MarshalJSON satisfies json.Marshaler, encoding a pair as an object with
+// tagged elements A and B.
+func (p OrderedPair) MarshalJSON() ([]byte, error) {
+	a, err := marshalElement(p.A)
+	if err != nil {
+		return nil, err
+	}
+	b, err := marshalElement(p.B)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(struct{ A, B taggedElement }{a, b})
+}
+
+// UnmarshalJSON satisfies json.Unmarshaler.
+func (p *OrderedPair) UnmarshalJSON(data []byte) error {
+	var t struct{ A, B taggedElement }
+	if err := json.Unmarshal(data, &t); err != nil {
+		return err
+	}
+	a, err := unmarshalElement(t.A)
+	if err != nil {
+		return err
+	}
+	b, err := unmarshalElement(t.B)
+	if err != nil {
+		return err
+	}
+	*p = OrderedPair{a, b}
+	return nil
+}
+
+// MarshalJSON satisfies json.Marshaler, encoding a tuple as an array of
+// tagged elements.
+func (t Tuple) MarshalJSON() ([]byte, error) { return marshalElements(t) }
+
+// UnmarshalJSON satisfies json.Unmarshaler.
+//
+// Unlike sets, tuples may have repeated components.
+func (t *Tuple) UnmarshalJSON(data []byte) error {
+	es, err := unmarshalElements(data)
+	if err != nil {
+		return err
+	}
+	*t = es
+	return nil
+}
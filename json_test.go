@@ -0,0 +1,65 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func init() {
+	set.RegisterElement[intEle]("int", nil)
+}
+
+func TestJSON(t *testing.T) {
+	s := set.SetM{
+		intEle(1),
+		set.SetM{intEle(2), intEle(3)},
+		set.SetM{},
+		set.Set{intEle(4)},
+		set.OrderedPair{intEle(5), set.SetM{intEle(6)}},
+		set.Tuple{intEle(7), intEle(7)},
+	}
+	b, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var r set.SetM
+	if err := json.Unmarshal(b, &r); err != nil {
+		t.Fatal(err)
+	}
+	if !r.Equal(s) {
+		t.Fatal("round trip:", string(b), r)
+	}
+	var p set.Set
+	if err := json.Unmarshal(b, &p); err != nil {
+		t.Fatal(err)
+	}
+	if !p.Equal(set.Set(s)) {
+		t.Fatal("round trip Set:", p)
+	}
+}
+
+func TestJSONErrors(t *testing.T) {
+	if _, err := json.Marshal(set.SetM{fEle(1)}); err == nil ||
+		!strings.Contains(err.Error(), "unregistered") {
+		t.Fatal("unregistered type:", err)
+	}
+	var s set.SetM
+	for _, in := range []string{
+		`[{"type":"int","value":1},{"type":"int","value":1}]`,
+		`[{"type":"SetM","value":[{"type":"int","value":2},{"type":"int","value":2}]}]`,
+		`[{"type":"SetM","value":[]},{"type":"SetM","value":[]}]`,
+		`[{"type":"float","value":1}]`,
+		`[{"type":"int","value":"x"}]`,
+		`{}`,
+	} {
+		if err := json.Unmarshal([]byte(in), &s); err == nil {
+			t.Error("no error:", in)
+		}
+	}
+}
@@ -0,0 +1,189 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"fmt"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Parser parses the set literal syntax printed by Set.String and
+// SetM.String, for example
+//
+//   {1 {2 3} {}}
+//
+// A set is a list of elements enclosed in braces and separated by white
+// space.  White space or a closing brace is required after each element.
+// An element is either a set or an atom, a run of characters other than
+// white space and braces.  Atoms are converted to elements by the Atom
+// function.
+//
+// Output of String round-trips through a Parser as long as the atom elements
+// print without white space or braces and Atom inverts their formatting.
+type Parser struct {
+	// Atom converts an atom to an element.  If Atom is nil, only sets of
+	// sets, such as {{} {{}}}, can be parsed.
+	Atom func(string) (Element, error)
+	// MergeDuplicates controls handling of duplicate elements within a set.
+	// If false, duplicates are reported as errors.  If true, duplicates
+	// after the first are dropped.
+	MergeDuplicates bool
+}
+
+// SyntaxError describes an error in parsing a set literal.
+type SyntaxError struct {
+	Offset int    // byte offset in the input where the error was detected
+	Msg    string // description of the error
+	Err    error  // error returned by Parser.Atom, if any
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("set: offset %d: %s: %v", e.Offset, e.Msg, e.Err)
+	}
+	return fmt.Sprintf("set: offset %d: %s", e.Offset, e.Msg)
+}
+
+// Unwrap returns the error returned by Parser.Atom, if any.
+func (e *SyntaxError) Unwrap() error { return e.Err }
+
+// ParseSet parses a set literal as a Set.  Nested sets are also parsed as
+// Set.
+//
+// An error is returned as a *SyntaxError.
+func (p Parser) ParseSet(s string) (Set, error) {
+	return p.parse(s, func(es []Element) Element { return Set(es) })
+}
+
+// ParseSetM parses a set literal as a SetM.  Nested sets are also parsed as
+// SetM.
+//
+// An error is returned as a *SyntaxError.
+func (p Parser) ParseSetM(s string) (SetM, error) {
+	return p.parse(s, func(es []Element) Element { return SetM(es) })
+}
+
+// parseState holds the input and position of a parse in progress.
+type parseState struct {
+	Parser
+	s  string
+	i  int
+	mk func([]Element) Element
+}
+
+func (p Parser) parse(s string, mk func([]Element) Element) ([]Element, error) {
+	ps := &parseState{Parser: p, s: s, mk: mk}
+	ps.skipSpace()
+	if ps.i == len(s) || s[ps.i] != '{' {
+		return nil, ps.syntaxError("expected {")
+	}
+	es, err := ps.set()
+	if err != nil {
+		return nil, err
+	}
+	ps.skipSpace()
+	if ps.i < len(s) {
+		return nil, ps.syntaxError("unexpected text after set")
+	}
+	if es == nil {
+		es = []Element{}
+	}
+	return es, nil
+}
+
+// syntaxError returns a SyntaxError at the current position.
+func (ps *parseState) syntaxError(msg string) *SyntaxError {
+	return &SyntaxError{Offset: ps.i, Msg: msg}
+}
+
+func (ps *parseState) skipSpace() {
+	for ps.i < len(ps.s) {
+		r, n := utf8.DecodeRuneInString(ps.s[ps.i:])
+		if !unicode.IsSpace(r) {
+			return
+		}
+		ps.i += n
+	}
+}
+
+// set parses a set starting at the opening brace.
+func (ps *parseState) set() (es []Element, err error) {
+	ps.i++ // '{'
+	for {
+		ps.skipSpace()
+		if ps.i == len(ps.s) {
+			return nil, ps.syntaxError("unclosed {")
+		}
+		start := ps.i
+		var e Element
+		switch ps.s[ps.i] {
+		case '}':
+			ps.i++
+			return es, nil
+		case '{':
+			sub, err := ps.set()
+			if err != nil {
+				return nil, err
+			}
+			if sub == nil {
+				sub = []Element{}
+			}
+			e = ps.mk(sub)
+		default:
+			if e, err = ps.atom(); err != nil {
+				return nil, err
+			}
+		}
+		if !ps.separated() {
+			return nil, ps.syntaxError("expected space or } after element")
+		}
+		if ps.has(es, e) {
+			if !ps.MergeDuplicates {
+				return nil, &SyntaxError{Offset: start, Msg: "duplicate element"}
+			}
+			continue
+		}
+		es = append(es, e)
+	}
+}
+
+// separated returns true if the current position is at white space or a
+// closing brace, as required after an element.  The end of input is also
+// accepted, to be reported as an unclosed set.
+func (ps *parseState) separated() bool {
+	if ps.i == len(ps.s) {
+		return true
+	}
+	r, _ := utf8.DecodeRuneInString(ps.s[ps.i:])
+	return r == '}' || unicode.IsSpace(r)
+}
+
+func (ps *parseState) atom() (Element, error) {
+	start := ps.i
+	for ps.i < len(ps.s) {
+		r, n := utf8.DecodeRuneInString(ps.s[ps.i:])
+		if r == '{' || r == '}' || unicode.IsSpace(r) {
+			break
+		}
+		ps.i += n
+	}
+	if ps.Atom == nil {
+		return nil, &SyntaxError{Offset: start, Msg: "unexpected atom"}
+	}
+	e, err := ps.Atom(ps.s[start:ps.i])
+	if err != nil {
+		return nil, &SyntaxError{Offset: start, Msg: "invalid atom", Err: err}
+	}
+	return e, nil
+}
+
+func (ps *parseState) has(es []Element, e Element) bool {
+	for _, x := range es {
+		if x.Equal(e) {
+			return true
+		}
+	}
+	return false
+}
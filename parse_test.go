@@ -0,0 +1,92 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+var intParser = set.Parser{Atom: func(s string) (set.Element, error) {
+	i, err := strconv.Atoi(s)
+	return intEle(i), err
+}}
+
+func TestParseRoundTrip(t *testing.T) {
+	s := set.SetM{
+		intEle(1),
+		set.SetM{intEle(2), intEle(3)},
+		set.SetM{},
+		set.SetM{set.SetM{}, set.SetM{intEle(-4)}},
+	}
+	for i := 0; i < 10; i++ { // String order is random
+		r, err := intParser.ParseSetM(s.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !r.Equal(s) {
+			t.Fatal("round trip:", s, r)
+		}
+	}
+	p, err := intParser.ParseSet(set.Set(s).String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(p) != 4 || !p.HasElement(set.Set{intEle(3), intEle(2)}) {
+		t.Fatal("ParseSet:", p)
+	}
+	// sets of sets need no Atom
+	v, err := set.Parser{}.ParseSetM(" { {}\t{{}}\n} ")
+	if err != nil || !v.Equal(set.SetM{set.SetM{}, set.SetM{set.SetM{}}}) {
+		t.Fatal("Parser{}:", v, err)
+	}
+	if e, err := intParser.ParseSetM("{}"); err != nil || e == nil || len(e) != 0 {
+		t.Fatal("empty set:", e, err)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	for _, tc := range []struct {
+		in     string
+		offset int
+	}{
+		{"", 0},
+		{"1", 0},
+		{"{1 2", 4},
+		{"{1 2}}", 5},
+		{"{1 x}", 3},
+		{"{1{2}}", 2},
+		{"{1{}}", 2},
+		{"{{}1}", 3},
+		{"{{}{}}", 3},
+		{"{1 {2 3} {3 2}}", 9},
+		{"{{} {{} {}}}", 8},
+	} {
+		_, err := intParser.ParseSetM(tc.in)
+		var se *set.SyntaxError
+		if !errors.As(err, &se) {
+			t.Errorf("%q: error %v", tc.in, err)
+			continue
+		}
+		if se.Offset != tc.offset {
+			t.Errorf("%q: offset %d, want %d (%v)", tc.in, se.Offset, tc.offset, err)
+		}
+	}
+	var ne *strconv.NumError
+	if _, err := intParser.ParseSetM("{x}"); !errors.As(err, &ne) {
+		t.Error("Atom error not wrapped:", err)
+	}
+	if _, err := (set.Parser{}).ParseSetM("{1}"); err == nil {
+		t.Error("atom with nil Atom")
+	}
+	merge := intParser
+	merge.MergeDuplicates = true
+	s, err := merge.ParseSetM("{1 {2 3} 1 {3 2}}")
+	if err != nil || !s.Ok() || !s.Equal(set.SetM{intEle(1), set.SetM{intEle(2), intEle(3)}}) {
+		t.Fatal("MergeDuplicates:", s, err)
+	}
+}
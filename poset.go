@@ -0,0 +1,312 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import "iter"
+
+// Poset is a partially ordered set.
+//
+// S is the set of elements.  Less is a strict partial order on S:  it must
+// be irreflexive and transitive.  Less(a, b) returns true if a precedes b.
+//
+// Construct a Poset directly, or with NewPoset from a relation or
+// SubsetPoset for a family of sets ordered by inclusion.
+type Poset struct {
+	S    SetM
+	Less func(a, b Element) bool
+}
+
+// NewPoset returns the poset on s ordered by relation r.
+//
+// The order is the transitive closure of r, ignoring pairs (a, a).
+// Thus r may be a partial order, strict or not, or any relation generating
+// one, such as a Hasse diagram or a list of dependencies.
+//
+// If the closure is not antisymmetric over s, that is, if r has a cycle
+// through distinct elements of s, NewPoset returns an empty Poset and false.
+func NewPoset(s SetM, r Relation) (Poset, bool) {
+	c := r.TransitiveClosure()
+	if !c.IsAntisymmetric(s) {
+		return Poset{}, false
+	}
+	return Poset{s, func(a, b Element) bool {
+		return !a.Equal(b) && c.Has(a, b)
+	}}, true
+}
+
+// SubsetPoset returns the poset of a family of sets ordered by inclusion.
+//
+// Elements of family must have dynamic type SetM.
+func SubsetPoset(family SetM) Poset {
+	return Poset{family, func(a, b Element) bool {
+		return a.(SetM).IsProperSubset(b.(SetM))
+	}}
+}
+
+// less returns the order of p as a matrix indexed like p.S.
+func (p Poset) less() [][]bool {
+	m := make([][]bool, len(p.S))
+	for i, a := range p.S {
+		m[i] = make([]bool, len(p.S))
+		for j, b := range p.S {
+			m[i][j] = i != j && p.Less(a, b)
+		}
+	}
+	return m
+}
+
+// Minimal returns the set of minimal elements, the elements with no
+// predecessor.
+func (p Poset) Minimal() (r SetM) {
+	m := p.less()
+a:
+	for i, a := range p.S {
+		for j := range p.S {
+			if m[j][i] {
+				continue a
+			}
+		}
+		r = append(r, a)
+	}
+	return
+}
+
+// Maximal returns the set of maximal elements, the elements with no
+// successor.
+func (p Poset) Maximal() (r SetM) {
+	m := p.less()
+a:
+	for i, a := range p.S {
+		for j := range p.S {
+			if m[i][j] {
+				continue a
+			}
+		}
+		r = append(r, a)
+	}
+	return
+}
+
+// Hasse returns the covering relation of p, the edges of its Hasse diagram.
+//
+// The result contains (a, b) where a precedes b and no element lies between
+// them.
+func (p Poset) Hasse() (h Relation) {
+	m := p.less()
+	for i := range p.S {
+	j:
+		for j := range p.S {
+			if !m[i][j] {
+				continue
+			}
+			for k := range p.S {
+				if m[i][k] && m[k][j] {
+					continue j
+				}
+			}
+			h = append(h, OrderedPair{p.S[i], p.S[j]})
+		}
+	}
+	return
+}
+
+// LinearExtension returns the elements of p in an order consistent with
+// the partial order, a topological sort.
+//
+// Among elements available at each step, the one earliest in p.S is chosen.
+//
+// See Poset.LinearExtensions to enumerate all linear extensions.
+func (p Poset) LinearExtension() []Element {
+	for l := range p.LinearExtensions() {
+		return l
+	}
+	return []Element{}
+}
+
+// LinearExtensions returns an iterator over all linear extensions of p.
+//
+// Each yielded slice is newly allocated.  The number of linear extensions
+// can be as large as n!.
+func (p Poset) LinearExtensions() iter.Seq[[]Element] {
+	return func(yield func([]Element) bool) {
+		m := p.less()
+		n := len(p.S)
+		// preds[j] is the number of unplaced predecessors of j.
+		preds := make([]int, n)
+		for i := range m {
+			for j := range m[i] {
+				if m[i][j] {
+					preds[j]++
+				}
+			}
+		}
+		placed := make([]bool, n)
+		order := make([]Element, 0, n)
+		var r func() bool
+		r = func() bool {
+			if len(order) == n {
+				return yield(append([]Element{}, order...))
+			}
+			for i := range p.S {
+				if placed[i] || preds[i] > 0 {
+					continue
+				}
+				placed[i] = true
+				order = append(order, p.S[i])
+				for j := range m[i] {
+					if m[i][j] {
+						preds[j]--
+					}
+				}
+				ok := r()
+				for j := range m[i] {
+					if m[i][j] {
+						preds[j]++
+					}
+				}
+				order = order[:len(order)-1]
+				placed[i] = false
+				if !ok {
+					return false
+				}
+			}
+			return true
+		}
+		r()
+	}
+}
+
+// LongestChain returns a longest chain of p, in order.
+//
+// A chain is a set of elements all comparable to one another.
+func (p Poset) LongestChain() []Element {
+	m := p.less()
+	n := len(p.S)
+	index := func(e Element) int {
+		for i, x := range p.S {
+			if e.Equal(x) {
+				return i
+			}
+		}
+		return -1
+	}
+	// length[i] is the length of the longest chain ending at i, prev[i]
+	// the previous element on that chain.
+	length := make([]int, n)
+	prev := make([]int, n)
+	end := -1
+	for _, e := range p.LinearExtension() {
+		j := index(e)
+		length[j], prev[j] = 1, -1
+		for i := range p.S {
+			if m[i][j] && length[i]+1 > length[j] {
+				length[j], prev[j] = length[i]+1, i
+			}
+		}
+		if end < 0 || length[j] > length[end] {
+			end = j
+		}
+	}
+	if end < 0 {
+		return []Element{}
+	}
+	c := make([]Element, length[end])
+	for i := end; i >= 0; i = prev[i] {
+		c[length[i]-1] = p.S[i]
+	}
+	return c
+}
+
+// matching returns a maximum matching in the bipartite graph with an edge
+// from left vertex i to right vertex j wherever i precedes j.  matchL[i] is
+// the right vertex matched to i, or -1.  matchR is the inverse.
+func (p Poset) matching(m [][]bool) (matchL, matchR []int) {
+	n := len(p.S)
+	matchL = make([]int, n)
+	matchR = make([]int, n)
+	for i := range matchL {
+		matchL[i], matchR[i] = -1, -1
+	}
+	var seen []bool
+	var augment func(int) bool
+	augment = func(i int) bool {
+		for j := 0; j < n; j++ {
+			if !m[i][j] || seen[j] {
+				continue
+			}
+			seen[j] = true
+			if matchR[j] < 0 || augment(matchR[j]) {
+				matchL[i], matchR[j] = j, i
+				return true
+			}
+		}
+		return false
+	}
+	for i := 0; i < n; i++ {
+		seen = make([]bool, n)
+		augment(i)
+	}
+	return
+}
+
+// Chains returns a partition of p into a minimum number of chains.
+//
+// By Dilworth's theorem, the number of chains equals the size of a maximum
+// antichain.  Each chain is in order.
+func (p Poset) Chains() [][]Element {
+	matchL, matchR := p.matching(p.less())
+	var cs [][]Element
+	for i := range p.S {
+		if matchR[i] >= 0 {
+			continue // not the start of a chain
+		}
+		var c []Element
+		for j := i; j >= 0; j = matchL[j] {
+			c = append(c, p.S[j])
+		}
+		cs = append(cs, c)
+	}
+	return cs
+}
+
+// MaxAntichain returns a maximum antichain of p.
+//
+// An antichain is a set of elements no two of which are comparable.
+// The algorithm constructs a minimum chain cover as a bipartite matching,
+// after Dilworth's theorem, and then takes an antichain from a minimum
+// vertex cover, after König's theorem.
+func (p Poset) MaxAntichain() (a SetM) {
+	m := p.less()
+	matchL, matchR := p.matching(m)
+	n := len(p.S)
+	// alternating search from unmatched left vertices
+	visL := make([]bool, n)
+	visR := make([]bool, n)
+	var visit func(int)
+	visit = func(i int) {
+		visL[i] = true
+		for j := 0; j < n; j++ {
+			if m[i][j] && !visR[j] {
+				visR[j] = true
+				if k := matchR[j]; k >= 0 && !visL[k] {
+					visit(k)
+				}
+			}
+		}
+	}
+	for i := range p.S {
+		if matchL[i] < 0 && !visL[i] {
+			visit(i)
+		}
+	}
+	// the minimum vertex cover is unvisited left vertices and visited
+	// right vertices.  elements with neither copy in the cover form
+	// the antichain.
+	for i, e := range p.S {
+		if visL[i] && !visR[i] {
+			a = append(a, e)
+		}
+	}
+	return
+}
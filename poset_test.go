@@ -0,0 +1,139 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+// divisibility order on 1..12
+func divPoset(t *testing.T) set.Poset {
+	var s set.SetM
+	var r set.Relation
+	for a := 1; a <= 12; a++ {
+		s = append(s, intEle(a))
+		for b := 2 * a; b <= 12; b += a {
+			r.Add(intEle(a), intEle(b))
+		}
+	}
+	p, ok := set.NewPoset(s, r)
+	if !ok {
+		t.Fatal("NewPoset")
+	}
+	return p
+}
+
+func TestPoset(t *testing.T) {
+	p := divPoset(t)
+	if m := p.Minimal(); !m.Equal(ints(1)) {
+		t.Fatal("Minimal:", m)
+	}
+	if m := p.Maximal(); !m.Equal(ints(7, 8, 9, 10, 11, 12)) {
+		t.Fatal("Maximal:", m)
+	}
+	h := p.Hasse()
+	if !h.Has(intEle(2), intEle(4)) || h.Has(intEle(2), intEle(8)) || !h.Has(intEle(1), intEle(11)) {
+		t.Fatal("Hasse:", h)
+	}
+	if !h.TransitiveClosure().Equal(mustClosure(p)) {
+		t.Fatal("Hasse closure")
+	}
+	l := p.LinearExtension()
+	for i := range l {
+		for j := 0; j < i; j++ {
+			if p.Less(l[i], l[j]) {
+				t.Fatal("LinearExtension out of order:", l)
+			}
+		}
+	}
+	if c := p.LongestChain(); len(c) != 4 || c[0] != intEle(1) || c[3] != intEle(8) {
+		t.Fatal("LongestChain:", c)
+	}
+	a := p.MaxAntichain()
+	if len(a) != 6 {
+		t.Fatal("MaxAntichain:", a)
+	}
+	for _, x := range a {
+		for _, y := range a {
+			if p.Less(x, y) {
+				t.Fatal("not an antichain:", a)
+			}
+		}
+	}
+	cs := p.Chains()
+	if len(cs) != len(a) {
+		t.Fatal("Dilworth:", cs)
+	}
+	n := 0
+	for _, c := range cs {
+		for i := 1; i < len(c); i++ {
+			if !p.Less(c[i-1], c[i]) {
+				t.Fatal("not a chain:", c)
+			}
+		}
+		n += len(c)
+	}
+	if n != len(p.S) {
+		t.Fatal("chains do not cover")
+	}
+}
+
+// mustClosure returns the order of p as a relation.
+func mustClosure(p set.Poset) (r set.Relation) {
+	for _, a := range p.S {
+		for _, b := range p.S {
+			if p.Less(a, b) {
+				r.Add(a, b)
+			}
+		}
+	}
+	return
+}
+
+func TestPosetCycle(t *testing.T) {
+	if _, ok := set.NewPoset(ints(1, 2, 3), rel([2]int{1, 2}, [2]int{2, 3}, [2]int{3, 1})); ok {
+		t.Fatal("accepted cycle")
+	}
+}
+
+func TestLinearExtensions(t *testing.T) {
+	// 1 < 3, 2 < 3:  extensions 1 2 3 and 2 1 3
+	p, _ := set.NewPoset(ints(1, 2, 3), rel([2]int{1, 3}, [2]int{2, 3}))
+	n := 0
+	for l := range p.LinearExtensions() {
+		if l[2] != intEle(3) {
+			t.Fatal("bad extension:", l)
+		}
+		n++
+	}
+	if n != 2 {
+		t.Fatal("LinearExtensions:", n)
+	}
+	// antichain of 4 has 4! extensions
+	n = 0
+	for range (set.Poset{ints(1, 2, 3, 4), func(a, b set.Element) bool { return false }}).LinearExtensions() {
+		n++
+	}
+	if n != 24 {
+		t.Fatal("LinearExtensions of antichain:", n)
+	}
+}
+
+func TestSubsetPoset(t *testing.T) {
+	p := set.SubsetPoset(ints(1, 2, 3).PowerSet())
+	if a := p.MaxAntichain(); len(a) != 3 {
+		t.Fatal("MaxAntichain:", a)
+	}
+	if c := p.LongestChain(); len(c) != 4 {
+		t.Fatal("LongestChain:", c)
+	}
+	if m := p.Minimal(); !m.Equal(set.SetM{set.SetM{}}) {
+		t.Fatal("Minimal:", m)
+	}
+	if h := p.Hasse(); len(h) != 12 {
+		t.Fatal("Hasse:", len(h))
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+// Quotient is a partition of a set into equivalence classes, or blocks.
+//
+// Each block has a canonical representative, one of its elements.
+// Construct a Quotient with SetM.QuotientBy or SetM.QuotientRel.
+type Quotient struct {
+	blocks []SetM
+	reps   []Element
+}
+
+// QuotientBy partitions s into classes of elements with equal keys.
+//
+// Elements a and b are in the same block if key(a).Equal(key(b)).
+// The representative of each block is the element of s first encountered
+// with that key.
+func (s SetM) QuotientBy(key func(Element) Element) Quotient {
+	var q Quotient
+	var keys []Element
+e:
+	for _, e := range s {
+		k := key(e)
+		for i, ki := range keys {
+			if k.Equal(ki) {
+				q.blocks[i] = append(q.blocks[i], e)
+				continue e
+			}
+		}
+		keys = append(keys, k)
+		q.blocks = append(q.blocks, SetM{e})
+		q.reps = append(q.reps, e)
+	}
+	return q
+}
+
+// QuotientRel partitions s into equivalence classes of relation r.
+//
+// Elements a and b are in the same block if r contains (a, b).
+// The representative of each block is the element of s first encountered
+// in that block.
+//
+// If r is not an equivalence relation over s, QuotientRel returns an empty
+// Quotient and false.
+func (s SetM) QuotientRel(r Relation) (Quotient, bool) {
+	if !r.IsEquivalence(s) {
+		return Quotient{}, false
+	}
+	return s.QuotientBy(func(e Element) Element {
+		// the key is the block itself, which is the same set for
+		// all elements of the block.
+		var b SetM
+		for _, x := range s {
+			if r.Has(e, x) {
+				b = append(b, x)
+			}
+		}
+		return b
+	}), true
+}
+
+// Block returns the block containing e.
+//
+// If e is not an element of the partitioned set, Block returns nil, false.
+func (q Quotient) Block(e Element) (SetM, bool) {
+	for _, b := range q.blocks {
+		if b.HasElement(e) {
+			return b, true
+		}
+	}
+	return nil, false
+}
+
+// Blocks returns the set of blocks.
+//
+// Elements of the result have dynamic type SetM.
+func (q Quotient) Blocks() SetM {
+	s := make(SetM, len(q.blocks))
+	for i, b := range q.blocks {
+		s[i] = b
+	}
+	return s
+}
+
+// Rep returns the canonical representative of the block containing e.
+//
+// If e is not an element of the partitioned set, Rep returns nil, false.
+func (q Quotient) Rep(e Element) (Element, bool) {
+	for i, b := range q.blocks {
+		if b.HasElement(e) {
+			return q.reps[i], true
+		}
+	}
+	return nil, false
+}
+
+// Reps returns the set of canonical representatives, one per block.
+func (q Quotient) Reps() SetM {
+	return append(SetM{}, q.reps...)
+}
+
+// relEle is an element with equality defined by a relation.  It allows
+// the functions Reflexive, Symmetric, and Transitive to validate a relation.
+type relEle struct {
+	e Element
+	r Relation
+}
+
+func (x relEle) Equal(y Element) bool {
+	return x.r.Has(x.e, y.(relEle).e)
+}
+
+// IsEquivalence returns true if r is an equivalence relation over carrier.
+//
+// An equivalence relation is reflexive, symmetric, and transitive.  These
+// are exactly the properties required of the Equal method of an Element, so
+// the check uses the functions Reflexive, Symmetric, and Transitive with r
+// in the role of Equal.
+func (r Relation) IsEquivalence(carrier SetM) bool {
+	w := make([]relEle, len(carrier))
+	for i, e := range carrier {
+		w[i] = relEle{e, r}
+	}
+	for _, a := range w {
+		if !Reflexive(a) {
+			return false
+		}
+		for _, b := range w {
+			if !Symmetric(a, b) {
+				return false
+			}
+			if !a.Equal(b) {
+				continue // Transitive is trivially true
+			}
+			for _, c := range w {
+				if !Transitive(a, b, c) {
+					return false
+				}
+			}
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func TestQuotientBy(t *testing.T) {
+	s := ints(1, 2, 3, 4, 5, 6, 7)
+	q := s.QuotientBy(func(e set.Element) set.Element { return e.(intEle) % 3 })
+	want := set.NewSetM(ints(3, 6), ints(1, 4, 7), ints(2, 5))
+	if b := q.Blocks(); !b.Equal(want) {
+		t.Fatal("Blocks:", b)
+	}
+	if r, ok := q.Rep(intEle(7)); !ok || r != intEle(1) {
+		t.Fatal("Rep:", r)
+	}
+	if b, ok := q.Block(intEle(5)); !ok || !b.Equal(ints(2, 5)) {
+		t.Fatal("Block:", b)
+	}
+	if _, ok := q.Rep(intEle(8)); ok {
+		t.Fatal("Rep of non-element")
+	}
+	if r := q.Reps(); !r.Equal(ints(1, 2, 3)) {
+		t.Fatal("Reps:", r)
+	}
+}
+
+func TestQuotientRel(t *testing.T) {
+	s := ints(1, 2, 3, 4)
+	// same parity
+	var r set.Relation
+	for _, a := range s {
+		for _, b := range s {
+			if a.(intEle)%2 == b.(intEle)%2 {
+				r.Add(a, b)
+			}
+		}
+	}
+	if !r.IsEquivalence(s) {
+		t.Fatal("parity not an equivalence")
+	}
+	q, ok := s.QuotientRel(r)
+	if !ok || !q.Blocks().Equal(set.NewSetM(ints(1, 3), ints(2, 4))) {
+		t.Fatal("QuotientRel:", q.Blocks())
+	}
+	if rp, _ := q.Rep(intEle(4)); rp != intEle(2) {
+		t.Fatal("Rep:", rp)
+	}
+	// not reflexive
+	if _, ok := s.QuotientRel(rel([2]int{1, 1})); ok {
+		t.Fatal("accepted non-reflexive relation")
+	}
+	// not symmetric
+	if ns := r.ReflexiveClosure(s); append(ns, set.OrderedPair{intEle(1), intEle(2)}).IsEquivalence(s) {
+		t.Fatal("accepted non-symmetric relation")
+	}
+	// not transitive
+	nt := rel([2]int{1, 2}, [2]int{2, 3}).SymmetricClosure().ReflexiveClosure(s)
+	if nt.IsEquivalence(s) {
+		t.Fatal("accepted non-transitive relation")
+	}
+	if !nt.TransitiveClosure().IsEquivalence(s) {
+		t.Fatal("closure not an equivalence")
+	}
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"math/rand"
+	"sync"
+)
+
+// Methods such as String, Do, IterFunc, Peek, and Pop visit or choose
+// elements in random order.  By default they use the top-level functions of
+// math/rand.  SetRandSource substitutes a caller supplied source, which
+// makes the order reproducible.
+
+var rnd struct {
+	sync.Mutex
+	r *rand.Rand
+}
+
+// SetRandSource sets the source of randomness used by methods that visit or
+// choose elements in random order.
+//
+// Calls are serialized, so src need not be safe for concurrent use.  The
+// order produced from a given seed is the same as that produced by
+// rand.Seed with the same seed and the default source.
+//
+// A nil src restores the default, the top-level functions of math/rand.
+func SetRandSource(src rand.Source) {
+	rnd.Lock()
+	defer rnd.Unlock()
+	if src == nil {
+		rnd.r = nil
+	} else {
+		rnd.r = rand.New(src)
+	}
+}
+
+// randPerm is rand.Perm using the source set by SetRandSource.
+func randPerm(n int) []int {
+	rnd.Lock()
+	defer rnd.Unlock()
+	if rnd.r == nil {
+		return rand.Perm(n)
+	}
+	return rnd.r.Perm(n)
+}
+
+// randIntn is rand.Intn using the source set by SetRandSource.
+func randIntn(n int) int {
+	rnd.Lock()
+	defer rnd.Unlock()
+	if rnd.r == nil {
+		return rand.Intn(n)
+	}
+	return rnd.r.Intn(n)
+}
@@ -0,0 +1,201 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+// Relation is a binary relation, represented as a set of ordered pairs.
+//
+// Relation has the underlying type of SetM and all rules for SetM apply.
+// Additionally all elements must have dynamic type OrderedPair.  A pair
+// (a, b) in the relation means a is related to b.
+//
+// Methods that check properties such as IsReflexive take a carrier set,
+// the set over which the relation is considered.  Only pairs with both
+// components in the carrier are considered.
+type Relation SetM
+
+// NewRelation returns a new relation with the given pairs.
+//
+// Duplicate pairs are not added.
+func NewRelation(ps ...OrderedPair) Relation {
+	var r Relation
+	for _, p := range ps {
+		r.Add(p.A, p.B)
+	}
+	return r
+}
+
+// Ok validates that all elements are OrderedPairs and that Equal returns
+// false for all pairs of elements.
+func (r Relation) Ok() bool {
+	for _, e := range r {
+		if _, ok := e.(OrderedPair); !ok {
+			return false
+		}
+	}
+	return SetM(r).Ok()
+}
+
+// Add adds the pair (a, b) to the relation.
+//
+// Returns true if the pair was added.  Returns false if it was already
+// present.
+func (p *Relation) Add(a, b Element) bool {
+	return (*SetM)(p).Add(OrderedPair{a, b})
+}
+
+// Compose returns the composition of r followed by s.
+//
+// The result contains (a, c) wherever r contains (a, b) and s contains (b, c)
+// for some b.  In the usual mathematical notation this is s ∘ r.
+func (r Relation) Compose(s Relation) (c Relation) {
+	for _, e := range r {
+		p := e.(OrderedPair)
+		for _, f := range s {
+			if q := f.(OrderedPair); p.B.Equal(q.A) {
+				c.Add(p.A, q.B)
+			}
+		}
+	}
+	return
+}
+
+// Domain returns the set of first components of pairs in r.
+func (r Relation) Domain() (d SetM) {
+	for _, e := range r {
+		d.Add(e.(OrderedPair).A)
+	}
+	return
+}
+
+// Equal satisfies the Element interface, allowing Relation values to be
+// elements of sets.
+//
+// If the dynamic type of the argument is not Relation or if the relations
+// do not contain the same pairs, Equal returns false.
+func (r Relation) Equal(e Element) bool {
+	s, ok := e.(Relation)
+	return ok && SetM(r).Equal(SetM(s))
+}
+
+// Field returns the union of the domain and range of r, the set of all
+// elements appearing in some pair of r.
+func (r Relation) Field() (f SetM) {
+	for _, e := range r {
+		p := e.(OrderedPair)
+		f.Add(p.A)
+		f.Add(p.B)
+	}
+	return
+}
+
+// Has returns true if r contains the pair (a, b).
+func (r Relation) Has(a, b Element) bool {
+	return SetM(r).HasElement(OrderedPair{a, b})
+}
+
+// Image returns the set of elements related to some element of x.
+//
+// That is, the set of b where r contains (a, b) for some a in x.
+func (r Relation) Image(x SetM) (y SetM) {
+	for _, e := range r {
+		if p := e.(OrderedPair); x.HasElement(p.A) {
+			y.Add(p.B)
+		}
+	}
+	return
+}
+
+// Inverse returns the inverse of r, the relation with each pair reversed.
+func (r Relation) Inverse() Relation {
+	v := make(Relation, len(r))
+	for i, e := range r {
+		p := e.(OrderedPair)
+		v[i] = OrderedPair{p.B, p.A}
+	}
+	return v
+}
+
+// IsAntisymmetric returns true if r is antisymmetric over carrier.
+//
+// That is, for distinct a and b in carrier, r does not contain both (a, b)
+// and (b, a).
+func (r Relation) IsAntisymmetric(carrier SetM) bool {
+	for _, e := range r {
+		p := e.(OrderedPair)
+		if !p.A.Equal(p.B) && carrier.HasAll(p.A, p.B) && r.Has(p.B, p.A) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsReflexive returns true if r is reflexive over carrier.
+//
+// That is, r contains (a, a) for every a in carrier.
+func (r Relation) IsReflexive(carrier SetM) bool {
+	for _, a := range carrier {
+		if !r.Has(a, a) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSymmetric returns true if r is symmetric over carrier.
+//
+// That is, for a and b in carrier, if r contains (a, b) then it also
+// contains (b, a).
+func (r Relation) IsSymmetric(carrier SetM) bool {
+	for _, e := range r {
+		p := e.(OrderedPair)
+		if carrier.HasAll(p.A, p.B) && !r.Has(p.B, p.A) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsTransitive returns true if r is transitive over carrier.
+//
+// That is, for a, b, and c in carrier, if r contains (a, b) and (b, c) then
+// it also contains (a, c).
+func (r Relation) IsTransitive(carrier SetM) bool {
+	for _, e := range r {
+		p := e.(OrderedPair)
+		if !carrier.HasAll(p.A, p.B) {
+			continue
+		}
+		for _, f := range r {
+			q := f.(OrderedPair)
+			if p.B.Equal(q.A) && carrier.HasElement(q.B) && !r.Has(p.A, q.B) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Preimage returns the set of elements related to some element of y.
+//
+// That is, the set of a where r contains (a, b) for some b in y.
+func (r Relation) Preimage(y SetM) (x SetM) {
+	for _, e := range r {
+		if p := e.(OrderedPair); y.HasElement(p.B) {
+			x.Add(p.A)
+		}
+	}
+	return
+}
+
+// Range returns the set of second components of pairs in r.
+func (r Relation) Range() (g SetM) {
+	for _, e := range r {
+		g.Add(e.(OrderedPair).B)
+	}
+	return
+}
+
+// String satisfies fmt.Stringer, providing a printable representation of a
+// relation.
+func (r Relation) String() string { return SetM(r).String() }
@@ -0,0 +1,104 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+// rel builds a relation on intEle from pairs of ints.
+func rel(ps ...[2]int) set.Relation {
+	var r set.Relation
+	for _, p := range ps {
+		r.Add(intEle(p[0]), intEle(p[1]))
+	}
+	return r
+}
+
+func ints(is ...int) set.SetM {
+	s := make(set.SetM, len(is))
+	for i, x := range is {
+		s[i] = intEle(x)
+	}
+	return s
+}
+
+func TestRelation(t *testing.T) {
+	// 1 → 2 → 3, 1 → 3
+	r := rel([2]int{1, 2}, [2]int{2, 3}, [2]int{1, 3})
+	if !r.Ok() {
+		t.Fatal("Ok")
+	}
+	if d := r.Domain(); !d.Equal(ints(1, 2)) {
+		t.Fatal("Domain:", d)
+	}
+	if g := r.Range(); !g.Equal(ints(2, 3)) {
+		t.Fatal("Range:", g)
+	}
+	if v := r.Inverse(); !v.Equal(rel([2]int{2, 1}, [2]int{3, 2}, [2]int{3, 1})) {
+		t.Fatal("Inverse:", v)
+	}
+	if c := r.Compose(r); !c.Equal(rel([2]int{1, 3})) {
+		t.Fatal("Compose:", c)
+	}
+	s := rel([2]int{3, 4})
+	if c := r.Compose(s); !c.Equal(rel([2]int{2, 4}, [2]int{1, 4})) {
+		t.Fatal("Compose:", c)
+	}
+	if y := r.Image(ints(1)); !y.Equal(ints(2, 3)) {
+		t.Fatal("Image:", y)
+	}
+	if x := r.Preimage(ints(3)); !x.Equal(ints(1, 2)) {
+		t.Fatal("Preimage:", x)
+	}
+	c := ints(1, 2, 3)
+	if r.IsReflexive(c) || !r.IsTransitive(c) || r.IsSymmetric(c) || !r.IsAntisymmetric(c) {
+		t.Fatal("properties of <")
+	}
+	if (set.Relation{intEle(1)}).Ok() {
+		t.Fatal("non-pair element Ok")
+	}
+}
+
+func TestRelationProperties(t *testing.T) {
+	c := ints(1, 2, 3)
+	// ≤ on {1 2 3}
+	var le set.Relation
+	for _, a := range c {
+		for _, b := range c {
+			if a.(intEle) <= b.(intEle) {
+				le.Add(a, b)
+			}
+		}
+	}
+	if !le.IsReflexive(c) || !le.IsTransitive(c) || !le.IsAntisymmetric(c) || le.IsSymmetric(c) {
+		t.Fatal("properties of ≤")
+	}
+	sym := rel([2]int{1, 2}, [2]int{2, 1})
+	if !sym.IsSymmetric(c) || sym.IsAntisymmetric(c) || sym.IsTransitive(c) {
+		t.Fatal("properties of symmetric relation")
+	}
+	// pairs outside the carrier are ignored
+	if !sym.IsTransitive(ints(3)) || !rel([2]int{1, 4}).IsSymmetric(c) {
+		t.Fatal("carrier restriction")
+	}
+	// relations on sets
+	sets := set.NewSetM(set.SetM{}, ints(1), ints(1, 2))
+	var sub set.Relation
+	for _, a := range sets {
+		for _, b := range sets {
+			if a.(set.SetM).IsProperSubset(b.(set.SetM)) {
+				sub.Add(a, b)
+			}
+		}
+	}
+	if !sub.IsTransitive(sets) || !sub.IsAntisymmetric(sets) || sub.IsReflexive(sets) {
+		t.Fatal("properties of proper subset")
+	}
+	if !sub.Has(set.SetM{}, ints(2, 1)) {
+		t.Fatal("Has")
+	}
+}
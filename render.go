@@ -0,0 +1,177 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+)
+
+// LaTeX renders sets as LaTeX math mode markup.
+//
+// Sets of type Set, SetM, Relation, and Function are rendered in braces
+// with elements separated by commas, as in \{1, \{2, 3\}\}.  The empty set
+// is rendered as \emptyset.  OrderedPair and Tuple values are rendered in
+// parentheses, as in (a, b).  Elements of a set are rendered in the order of
+// Canonical.
+//
+// Other elements are rendered with the Element function, if it is not nil
+// and returns true, or else with fmt.Sprint, escaping LaTeX special
+// characters.  A caret is rendered with \text, which requires the amsmath
+// package.
+type LaTeX struct {
+	Element func(Element) (string, bool)
+}
+
+// Render returns LaTeX markup for e.
+func (r LaTeX) Render(e Element) string {
+	var b strings.Builder
+	r.render(&b, e)
+	return b.String()
+}
+
+func (r LaTeX) render(b *strings.Builder, e Element) {
+	if s, ok := renderSet(e); ok {
+		if len(s) == 0 {
+			b.WriteString(`\emptyset`)
+			return
+		}
+		b.WriteString(`\{`)
+		for i, x := range s {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			r.render(b, x)
+		}
+		b.WriteString(`\}`)
+		return
+	}
+	if t, ok := renderTuple(e); ok {
+		b.WriteString("(")
+		for i, x := range t {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			r.render(b, x)
+		}
+		b.WriteString(")")
+		return
+	}
+	if r.Element != nil {
+		if s, ok := r.Element(e); ok {
+			b.WriteString(s)
+			return
+		}
+	}
+	b.WriteString(latexEscaper.Replace(fmt.Sprint(e)))
+}
+
+var latexEscaper = strings.NewReplacer(
+	`\`, `\backslash{}`,
+	`{`, `\{`,
+	`}`, `\}`,
+	`#`, `\#`,
+	`$`, `\$`,
+	`%`, `\%`,
+	`&`, `\&`,
+	`_`, `\_`,
+	`~`, `\sim{}`,
+	`^`, `\text{\textasciicircum}`,
+)
+
+// MathML renders sets as presentation MathML.
+//
+// Sets are rendered as in LaTeX, with braces, commas, and parentheses as mo
+// elements and the empty set as the symbol ∅.  Other elements are rendered
+// with the Element function, if it is not nil and returns true, or else as
+// an mn element if fmt.Sprint formats the element as a number and as an mi
+// element otherwise.
+type MathML struct {
+	Element func(Element) (string, bool)
+}
+
+// Render returns a MathML math element for e.
+func (r MathML) Render(e Element) string {
+	var b strings.Builder
+	b.WriteString(`<math xmlns="http://www.w3.org/1998/Math/MathML">`)
+	r.render(&b, e)
+	b.WriteString("</math>")
+	return b.String()
+}
+
+func (r MathML) render(b *strings.Builder, e Element) {
+	list := func(open, close string, es []Element) {
+		b.WriteString("<mrow><mo>" + open + "</mo>")
+		for i, x := range es {
+			if i > 0 {
+				b.WriteString("<mo>,</mo>")
+			}
+			r.render(b, x)
+		}
+		b.WriteString("<mo>" + close + "</mo></mrow>")
+	}
+	if s, ok := renderSet(e); ok {
+		if len(s) == 0 {
+			b.WriteString("<mi>∅</mi>")
+		} else {
+			list("{", "}", s)
+		}
+		return
+	}
+	if t, ok := renderTuple(e); ok {
+		list("(", ")", t)
+		return
+	}
+	if r.Element != nil {
+		if s, ok := r.Element(e); ok {
+			b.WriteString(s)
+			return
+		}
+	}
+	s := fmt.Sprint(e)
+	if _, err := strconv.ParseFloat(s, 64); err == nil {
+		b.WriteString("<mn>" + html.EscapeString(s) + "</mn>")
+	} else {
+		b.WriteString("<mi>" + html.EscapeString(s) + "</mi>")
+	}
+}
+
+// renderSet returns the elements of e in canonical order if e is a set.
+func renderSet(e Element) ([]Element, bool) {
+	var s []Element
+	switch e := e.(type) {
+	case Set:
+		s = e
+	case SetM:
+		s = e
+	case Relation:
+		s = e
+	case Function:
+		s = e
+	default:
+		return nil, false
+	}
+	c := make([]string, len(s))
+	for i, x := range s {
+		c[i] = Canonical(x)
+	}
+	r := make([]Element, len(s))
+	for i, j := range canonicalPerm(s, c) {
+		r[i] = s[j]
+	}
+	return r, true
+}
+
+// renderTuple returns the components of e if e is an OrderedPair or Tuple.
+func renderTuple(e Element) ([]Element, bool) {
+	switch e := e.(type) {
+	case OrderedPair:
+		return []Element{e.A, e.B}, true
+	case Tuple:
+		return e, true
+	}
+	return nil, false
+}
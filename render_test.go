@@ -0,0 +1,80 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+type name string
+
+func (n name) Equal(e set.Element) bool { return n == e }
+
+func TestLaTeX(t *testing.T) {
+	s := set.SetM{
+		intEle(1),
+		set.SetM{intEle(3), intEle(2)},
+		set.SetM{},
+		set.OrderedPair{name("a_1"), intEle(2)},
+	}
+	var r set.LaTeX
+	if got, want := r.Render(s), `\{1, \{2, 3\}, (a\_1, 2), \emptyset\}`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	rel := set.Relation{set.OrderedPair{intEle(2), intEle(1)}}
+	if got, want := r.Render(rel), `\{(2, 1)\}`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+	fn := set.Tabulate(ints(2, 1), func(e set.Element) set.Element { return e.(intEle) * 3 })
+	if got, want := r.Render(fn), `\{(1, 3), (2, 6)\}`; got != want {
+		t.Fatalf("Function: got %s, want %s", got, want)
+	}
+	tp := set.Tuple{intEle(1), set.SetM{}, name("a^b")}
+	if got, want := r.Render(tp), `(1, \emptyset, a\text{\textasciicircum}b)`; got != want {
+		t.Fatalf("Tuple: got %s, want %s", got, want)
+	}
+	r.Element = func(e set.Element) (string, bool) {
+		if n, ok := e.(name); ok {
+			return `\mathit{` + string(n) + `}`, true
+		}
+		return "", false
+	}
+	if got, want := r.Render(set.Set{name("x"), intEle(1)}), `\{1, \mathit{x}\}`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
+
+func TestMathML(t *testing.T) {
+	s := set.SetM{
+		intEle(1),
+		set.SetM{},
+		set.OrderedPair{name("a<b"), intEle(2)},
+	}
+	var r set.MathML
+	want := `<math xmlns="http://www.w3.org/1998/Math/MathML">` +
+		`<mrow><mo>{</mo>` +
+		`<mn>1</mn><mo>,</mo>` +
+		`<mrow><mo>(</mo><mi>a&lt;b</mi><mo>,</mo><mn>2</mn><mo>)</mo></mrow>` +
+		`<mo>,</mo><mi>∅</mi>` +
+		`<mo>}</mo></mrow></math>`
+	if got := r.Render(s); got != want {
+		t.Fatalf("got\n%s\nwant\n%s", got, want)
+	}
+	fn := set.Tabulate(ints(1), func(e set.Element) set.Element { return e })
+	if got, want := r.Render(fn),
+		`<math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><mo>{</mo>`+
+			`<mrow><mo>(</mo><mn>1</mn><mo>,</mo><mn>1</mn><mo>)</mo></mrow>`+
+			`<mo>}</mo></mrow></math>`; got != want {
+		t.Fatalf("Function: got %s, want %s", got, want)
+	}
+	r.Element = func(e set.Element) (string, bool) {
+		return "<ms>" + string(e.(name)) + "</ms>", true
+	}
+	if got, want := r.Render(set.Set{name("x")}),
+		`<math xmlns="http://www.w3.org/1998/Math/MathML"><mrow><mo>{</mo><ms>x</ms><mo>}</mo></mrow></math>`; got != want {
+		t.Fatalf("got %s, want %s", got, want)
+	}
+}
@@ -5,7 +5,7 @@ package set
 
 import (
 	"fmt"
-	"math/rand"
+	"iter"
 )
 
 // An Element can be an element of a Set.
@@ -135,9 +135,12 @@ func (p *Set) RemoveElement(e Element) {
 }
 
 // String satisfies fmt.Stringer, providing a printable representation of a set.
+//
+// Elements are printed in random order.  See Canonical for a deterministic
+// representation and SetRandSource for reproducible random order.
 func (s Set) String() string {
 	r := "{"
-	for i, j := range rand.Perm(len(s)) {
+	for i, j := range randPerm(len(s)) {
 		if i > 0 {
 			r += " "
 		}
@@ -161,3 +164,37 @@ func (s Set) PowerSet() Set {
 	}
 	return r
 }
+
+// All returns an iterator over elements of s.
+//
+// The set is not copied.  Changes to s during iteration may be reflected
+// in the elements yielded.
+func (s Set) All() iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		for _, e := range s {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Subsets returns an iterator over the subsets of s.
+//
+// This yields the elements of s.PowerSet() one at a time without
+// constructing the power set.
+func (s Set) Subsets() iter.Seq[Set] {
+	return func(yield func(Set) bool) {
+		var r func(int, Set) bool
+		r = func(i int, sub Set) bool {
+			if i == len(s) {
+				return yield(sub)
+			}
+			// extend sub before yielding it, so a caller modifying a
+			// yielded set cannot affect later subsets.
+			return r(i+1, append(sub[:len(sub):len(sub)], s[i])) &&
+				r(i+1, sub)
+		}
+		r(0, Set{})
+	}
+}
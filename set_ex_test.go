@@ -21,7 +21,8 @@ func (i intEle) Equal(e set.Element) bool {
 }
 
 func Example() {
-	rand.Seed(123)
+	set.SetRandSource(rand.NewSource(123))
+	defer set.SetRandSource(nil)
 
 	var s set.Set
 	fmt.Println(s)
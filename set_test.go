@@ -89,3 +89,27 @@ func TestPower(t *testing.T) {
 		}
 	}
 }
+
+func TestSubsets(t *testing.T) {
+	s := set.Set{intEle(1), intEle(2), intEle(3), intEle(4)}
+	ps := s.PowerSet()
+	var n int
+	for sub := range s.Subsets() {
+		if !ps.HasElement(sub) {
+			t.Fatal("subset not in power set:", sub)
+		}
+		n++
+	}
+	if n != len(ps) {
+		t.Fatal("subsets:", n)
+	}
+	n = 0
+	for e := range s.All() {
+		if !s.HasElement(e) {
+			t.Fatal("All:", e)
+		}
+		if n++; n == 2 {
+			break
+		}
+	}
+}
@@ -0,0 +1,532 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+)
+
+// A Hasher is an Element that can also compute a hash of its value.
+//
+// Implementing Hasher is optional.  Set types that use hashing, such as SetH,
+// use Hash only to find candidate elements.  Equal remains the final arbiter
+// of element equality.
+//
+// For a valid implementation, Hash must be consistent with Equal:
+// if a.Equal(b) then a.Hash() == b.Hash().  Elements that do not implement
+// Hasher are treated as all having the same hash.  An element type that does
+// not implement Hasher should thus not be Equal to one that does.
+type Hasher interface {
+	Element
+	Hash() uint64
+}
+
+// hash returns the hash of e if e implements Hasher, or 0 otherwise.
+func hash(e Element) uint64 {
+	if h, ok := e.(Hasher); ok {
+		return h.Hash()
+	}
+	return 0
+}
+
+// mix scrambles the bits of a hash.  It is used when combining element
+// hashes into the hash of a composite value.
+func mix(h uint64) uint64 {
+	h ^= h >> 33
+	h *= 0xff51afd7ed558ccd
+	h ^= h >> 33
+	h *= 0xc4ceb9fe1a85ec53
+	h ^= h >> 33
+	return h
+}
+
+// SetH is a type implementing the mathematical concept of a set.
+//
+// SetH has the same methods as SetM but elements are kept in buckets
+// indexed by hash.  Elements implementing Hasher are spread over buckets
+// so that most operations avoid a linear scan of the whole set.  Elements
+// not implementing Hasher all land in a single bucket, where SetH performs
+// no better than SetM.
+//
+// Each bucket is a SetM holding elements with the same hash.  All rules for
+// the SetM type apply to the buckets.  Empty buckets are not kept.
+//
+// Methods that modify a SetH have pointer receivers so that the zero value,
+// a nil map, is an empty set ready to use.
+type SetH map[uint64]SetM
+
+// NewSetH returns a new set with the given elements.
+func NewSetH(es ...Element) SetH {
+	n := SetH{}
+	for _, e := range es {
+		n.Add(e)
+	}
+	return n
+}
+
+// Ok validates that Equal returns false for all pairs of elements and that
+// each element is in the bucket for its hash.
+func (s SetH) Ok() bool {
+	for h, b := range s {
+		if len(b) == 0 || !b.Ok() {
+			return false
+		}
+		for _, e := range b {
+			if hash(e) != h {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Add adds a single element to a set.
+//
+// Returns true if e was added.  Returns false if e was already present.
+//
+// See SetH.AddV for a variadic version.
+func (r *SetH) Add(e Element) bool {
+	if *r == nil {
+		*r = SetH{}
+	}
+	h := hash(e)
+	b := (*r)[h]
+	if !b.Add(e) {
+		return false
+	}
+	(*r)[h] = b
+	return true
+}
+
+// AddV adds multiple elements to a set.
+//
+// Returns true if any element was added.  Returns false if all argument
+// elements were already present.
+func (r *SetH) AddV(es ...Element) (added bool) {
+	for _, e := range es {
+		if r.Add(e) {
+			added = true
+		}
+	}
+	return
+}
+
+// All returns an iterator over elements of s.
+//
+// Elements are yielded bucket by bucket, in no particular order.
+func (s SetH) All() iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		for _, b := range s {
+			for _, e := range b {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Cardinality returns the number of elements in the set.
+//
+// The count takes time proportional to the number of buckets.
+func (s SetH) Cardinality() (n int) {
+	for _, b := range s {
+		n += len(b)
+	}
+	return
+}
+
+// CartesianProduct returns a new set containing the cartesian product of s
+// and t.
+//
+// Elements of the result will have the dynamic type OrderedPair.
+func (s SetH) CartesianProduct(t SetH) SetH {
+	p := SetH{}
+	for _, bs := range s {
+		for _, es := range bs {
+			for _, bt := range t {
+				for _, et := range bt {
+					op := OrderedPair{es, et}
+					h := op.Hash()
+					p[h] = append(p[h], op)
+				}
+			}
+		}
+	}
+	return p
+}
+
+// Clear removes all elements from the set, leaving the empty set.
+func (r *SetH) Clear() { *r = nil }
+
+// Copy returns a copy, or a clone of a set.
+//
+// The map and the buckets are newly allocated.  Elements are shared.
+func (s SetH) Copy() SetH {
+	c := make(SetH, len(s))
+	for h, b := range s {
+		c[h] = b.Copy()
+	}
+	return c
+}
+
+// Difference returns a new set containing elements of s not in t.
+//
+// See SetH.DifferenceR for a version that modifies the receiver.
+//
+// See SetH.DifferenceV for a variadic version.
+func (s SetH) Difference(t SetH) SetH {
+	d := SetH{}
+	for h, b := range s {
+		if db := b.Difference(t[h]); len(db) > 0 {
+			d[h] = db
+		}
+	}
+	return d
+}
+
+// DifferenceV returns a new set containing elements that are in s but not in
+// any of the sets ts.
+func (s SetH) DifferenceV(ts ...SetH) SetH {
+	d := SetH{}
+	for h, b := range s {
+		bs := make([]SetM, len(ts))
+		for i, t := range ts {
+			bs[i] = t[h]
+		}
+		if db := b.DifferenceV(bs...); len(db) > 0 {
+			d[h] = db
+		}
+	}
+	return d
+}
+
+// DifferenceR modifies receiver r to be the difference r - c.
+func (r *SetH) DifferenceR(c SetH) {
+	for _, b := range c {
+		for _, e := range b {
+			r.Remove(e)
+		}
+	}
+}
+
+// Do calls f on each element of s, in random order.
+func (s SetH) Do(f func(Element)) { s.SetM().Do(f) }
+
+// DoWhile calls f on each element of s, in random order, as long as f returns
+// true.
+//
+// DoWhile returns true if f returns true for all elements of s.
+// If f returns false for an element, DoWhile returns false immediately
+// without calling f on any remaining elements.
+func (s SetH) DoWhile(f func(Element) bool) bool { return s.SetM().DoWhile(f) }
+
+// Equal satisfies the Element interface, allowing SetH values to be elements
+// of sets.
+//
+// If the dynamic type of the argument is not SetH or if the sets are not
+// equal, Equal returns false.
+func (s SetH) Equal(e Element) bool {
+	t, ok := e.(SetH)
+	if !ok || len(s) != len(t) {
+		return false
+	}
+	for h, b := range s {
+		if !b.Equal(t[h]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Filter returns the subset containing elements e of s where f(e) is true.
+func (s SetH) Filter(f func(Element) bool) SetH {
+	r := SetH{}
+	for h, b := range s {
+		if rb := b.Filter(f); len(rb) > 0 {
+			r[h] = rb
+		}
+	}
+	return r
+}
+
+// Flatten flattens a set potentially containing nested sets.
+//
+// Flatten returns a new non-nested set containing all non-SetH elements in s
+// at any level of nesting.
+func (s SetH) Flatten() SetH {
+	f := SetH{}
+	for _, b := range s {
+		for _, e := range b {
+			if s2, ok := e.(SetH); ok {
+				f.UnionR(s2.Flatten())
+			} else {
+				f.Add(e)
+			}
+		}
+	}
+	return f
+}
+
+// Hash satisfies the Hasher interface, allowing SetH values to be elements
+// of SetH values efficiently.
+//
+// The hash is independent of the order in which elements were added.
+func (s SetH) Hash() (h uint64) {
+	for eh, b := range s {
+		h += mix(eh) * uint64(len(b))
+	}
+	return
+}
+
+// HasAll tests whether the given elements are all in the set.
+func (s SetH) HasAll(es ...Element) bool {
+	for _, e := range es {
+		if !s.HasElement(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny tests whether any of the given elements are in the set.
+func (s SetH) HasAny(es ...Element) bool {
+	for _, e := range es {
+		if s.HasElement(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasElement returns true if set s contains element e.
+//
+// Only the bucket for the hash of e is searched.
+func (s SetH) HasElement(e Element) bool {
+	return s[hash(e)].HasElement(e)
+}
+
+// Intersect returns a new set of elements of s also in t.
+//
+// See SetH.IntersectV for a variadic version.
+func (s SetH) Intersect(t SetH) SetH {
+	// Range over the map with fewer buckets but take elements from s.
+	r := s
+	if len(t) < len(s) {
+		r = t
+	}
+	i := SetH{}
+	for h := range r {
+		if ib := s[h].Intersect(t[h]); len(ib) > 0 {
+			i[h] = ib
+		}
+	}
+	return i
+}
+
+// IntersectV returns a new set of elements of s that are also present in
+// all sets ts.
+func (s SetH) IntersectV(ts ...SetH) SetH {
+	i := SetH{}
+	for h, b := range s {
+		bs := make([]SetM, len(ts))
+		for j, t := range ts {
+			bs[j] = t[h]
+		}
+		if ib := b.IntersectV(bs...); len(ib) > 0 {
+			i[h] = ib
+		}
+	}
+	return i
+}
+
+// IsEmpty returns true if s is the empty set.
+func (s SetH) IsEmpty() bool { return len(s) == 0 }
+
+// IsProperSubset returns true if s is a proper subset of t.
+func (s SetH) IsProperSubset(t SetH) bool {
+	return s.Cardinality() < t.Cardinality() && s.IsSubset(t)
+}
+
+// IsSubset returns true if s is a subset of t.
+func (s SetH) IsSubset(t SetH) bool {
+	if len(s) > len(t) {
+		return false
+	}
+	for h, b := range s {
+		tb := t[h]
+		if len(b) > len(tb) || !tb.HasAll(b...) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if s is a superset of t.
+func (s SetH) IsSuperset(t SetH) bool {
+	return t.IsSubset(s)
+}
+
+// Iter sends elements of s on the returned channel.
+//
+// The channel is unbuffered.  The elements are collected before Iter returns
+// but a goroutine is left sending them.  The goroutine exits only after all
+// elements have been received.
+//
+// The channel is closed after all elements are sent.
+func (s SetH) Iter() <-chan Element { return s.SetM().Iter() }
+
+// IterFunc returns a function that iterates over elements of s in random
+// order.
+//
+// The ok return will be true for each element of s, then false on any
+// call afterwards.
+func (s SetH) IterFunc() func() (e Element, ok bool) { return s.SetM().IterFunc() }
+
+// IterBuffered sends elements of s in random order on the returned channel.
+//
+// The channel is closed after all elements are sent.
+func (s SetH) IterBuffered() <-chan Element { return s.SetM().IterBuffered() }
+
+// Map returns the set of distinct values f(e) for all e in s.
+//
+// The cardinality of the result m may be less that the cardinality of s.
+func (s SetH) Map(f func(Element) Element) SetH {
+	m := SetH{}
+	for _, b := range s {
+		for _, e := range b {
+			m.Add(f(e))
+		}
+	}
+	return m
+}
+
+// Peek returns a random element of s.
+//
+// If s is empty, Peek returns nil, false.
+func (s SetH) Peek() (e Element, ok bool) { return s.SetM().Peek() }
+
+// Pop returns a random element of r and removes it from r.
+//
+// If r is empty, Pop returns nil, false.
+func (r *SetH) Pop() (e Element, ok bool) {
+	if e, ok = r.Peek(); ok {
+		r.Remove(e)
+	}
+	return
+}
+
+// PowerSet returns the power set of a set.
+//
+// The power set of s is the set of all possible subsets of s.
+// Elements of the result have the dynamic type SetH.
+func (s SetH) PowerSet() SetH {
+	p := SetH{}
+	for _, e := range s.SetM().PowerSet() {
+		p.Add(NewSetH(e.(SetM)...))
+	}
+	return p
+}
+
+// Remove removes a single element from a set.
+//
+// Returns true if the element was found and removed.
+// Returns false if the element was not found.
+func (r *SetH) Remove(e Element) bool {
+	h := hash(e)
+	b, ok := (*r)[h]
+	if !ok || !b.Remove(e) {
+		return false
+	}
+	if len(b) == 0 {
+		delete(*r, h)
+	} else {
+		(*r)[h] = b
+	}
+	return true
+}
+
+// RemoveIf removes all elements where f returns true.
+//
+// Returns true if any element was removed.
+// Returns false if no elements were removed.
+func (r *SetH) RemoveIf(f func(Element) bool) (removed bool) {
+	for h, b := range *r {
+		if b.RemoveIf(f) {
+			removed = true
+			if len(b) == 0 {
+				delete(*r, h)
+			} else {
+				(*r)[h] = b
+			}
+		}
+	}
+	return
+}
+
+// SetM returns the elements of s as a SetM.
+//
+// The result is a new slice.  Elements are shared.  Buckets are visited in
+// order of hash value so the result does not depend on map iteration order.
+func (s SetH) SetM() SetM {
+	hs := make([]uint64, 0, len(s))
+	n := 0
+	for h, b := range s {
+		hs = append(hs, h)
+		n += len(b)
+	}
+	sort.Slice(hs, func(i, j int) bool { return hs[i] < hs[j] })
+	m := make(SetM, 0, n)
+	for _, h := range hs {
+		m = append(m, s[h]...)
+	}
+	return m
+}
+
+// String satisfies fmt.Stringer, providing a printable representation of a set.
+func (s SetH) String() string {
+	return fmt.Sprint(s.SetM())
+}
+
+// SymmetricDifference returns a new set with elements in s or t but not both.
+func (s SetH) SymmetricDifference(t SetH) SetH {
+	d := s.Difference(t)
+	for h, b := range t {
+		if db := b.Difference(s[h]); len(db) > 0 {
+			d[h] = append(d[h], db...)
+		}
+	}
+	return d
+}
+
+// Union returns a new set with elements of s or t.
+//
+// See SetH.UnionR for a version that modifies the receiver.
+//
+// See SetH.UnionV for a variadic version.
+func (s SetH) Union(t SetH) SetH {
+	u := s.Copy()
+	u.UnionR(t)
+	return u
+}
+
+// UnionR produces a union by modifying receiver r to include elements of t.
+func (r *SetH) UnionR(t SetH) {
+	for _, b := range t {
+		for _, e := range b {
+			r.Add(e)
+		}
+	}
+}
+
+// UnionV returns a new set with elements of s or any of ts.
+func (s SetH) UnionV(ts ...SetH) SetH {
+	u := s.Copy()
+	for _, t := range ts {
+		u.UnionR(t)
+	}
+	return u
+}
@@ -0,0 +1,116 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+// integer type satisfying Hasher, with a deliberately poor hash
+// so that buckets hold several elements.
+type hEle int
+
+func (i hEle) Equal(e set.Element) bool {
+	j, ok := e.(hEle)
+	return ok && i == j
+}
+
+func (i hEle) Hash() uint64 { return uint64(i % 7) }
+
+// case-insensitive string type satisfying Hasher, so that elements can be
+// Equal without being identical.
+type ciEle string
+
+func (s ciEle) Equal(e set.Element) bool {
+	t, ok := e.(ciEle)
+	return ok && strings.EqualFold(string(s), string(t))
+}
+
+func (s ciEle) Hash() uint64 { return uint64(len(s)) }
+
+func TestSetH(t *testing.T) {
+	var s set.SetH
+	for i := 0; i < 100; i++ {
+		s.Add(hEle(i % 50))
+	}
+	if !s.Ok() || s.Cardinality() != 50 {
+		t.Fatal("add:", s.Cardinality())
+	}
+	if !s.HasElement(hEle(49)) || s.HasElement(hEle(50)) {
+		t.Fatal("HasElement")
+	}
+	u := set.NewSetH(hEle(40), hEle(60), intEle(1))
+	if !u.HasElement(intEle(1)) || u.HasElement(intEle(2)) {
+		t.Fatal("non-Hasher element")
+	}
+	if c := s.Union(u).Cardinality(); c != 52 {
+		t.Fatal("Union:", c)
+	}
+	if i := s.Intersect(u); !i.Equal(set.NewSetH(hEle(40))) {
+		t.Fatal("Intersect:", i)
+	}
+	if d := u.Difference(s); !d.Equal(set.NewSetH(hEle(60), intEle(1))) {
+		t.Fatal("Difference:", d)
+	}
+	if d := s.SymmetricDifference(u); d.Cardinality() != 51 || !d.Ok() {
+		t.Fatal("SymmetricDifference:", d.Cardinality())
+	}
+	if !s.Intersect(u).IsProperSubset(s) || !s.IsSubset(s) || s.IsProperSubset(s) {
+		t.Fatal("subset")
+	}
+	for i := 0; i < 50; i += 2 {
+		s.Remove(hEle(i))
+	}
+	if !s.Ok() || s.Cardinality() != 25 || s.HasElement(hEle(0)) {
+		t.Fatal("Remove")
+	}
+	s.RemoveIf(func(e set.Element) bool { return e.(hEle)%7 == 0 })
+	if !s.Ok() || s.Cardinality() != 21 {
+		t.Fatal("RemoveIf:", s.Cardinality())
+	}
+	for n := s.Cardinality(); n > 0; n-- {
+		if _, ok := s.Pop(); !ok {
+			t.Fatal("Pop")
+		}
+	}
+	if !s.IsEmpty() {
+		t.Fatal("IsEmpty")
+	}
+}
+
+func TestSetHNested(t *testing.T) {
+	a := set.NewSetH(hEle(1), hEle(2))
+	b := set.NewSetH(hEle(2), hEle(1))
+	if a.Hash() != b.Hash() || !a.Equal(b) {
+		t.Fatal("order dependence")
+	}
+	p := set.NewSetH(hEle(1), hEle(2), hEle(3)).PowerSet()
+	if !p.Ok() || p.Cardinality() != 8 || !p.HasElement(a) {
+		t.Fatal("PowerSet")
+	}
+	if f := p.Flatten(); f.Cardinality() != 3 {
+		t.Fatal("Flatten:", f)
+	}
+	c := a.CartesianProduct(b)
+	if !c.Ok() || c.Cardinality() != 4 {
+		t.Fatal("CartesianProduct")
+	}
+}
+
+func TestSetHIntersectKeepsS(t *testing.T) {
+	s := set.NewSetH(ciEle("Apple"), ciEle("xy"), ciEle("xyz"))
+	u := set.NewSetH(ciEle("APPLE"))
+	for _, i := range []set.SetH{s.Intersect(u), s.IntersectV(u)} {
+		if e, ok := i.Pop(); !ok || e != ciEle("Apple") || !i.IsEmpty() {
+			t.Fatal("Intersect:", e)
+		}
+	}
+	i := u.Intersect(s)
+	if e, _ := i.Pop(); e != ciEle("APPLE") {
+		t.Fatal("Intersect:", e)
+	}
+}
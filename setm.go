@@ -5,7 +5,7 @@ package set
 
 import (
 	"fmt"
-	"math/rand"
+	"iter"
 )
 
 // SetM is a type implementing the mathematical concept of a set.
@@ -87,6 +87,25 @@ func (r *SetM) AddV(es ...Element) bool {
 	}
 }
 
+// All returns an iterator over elements of s.
+//
+// Use it with a range loop:
+//
+//   for e := range s.All() {
+//
+// Unlike SetM.Iter, no goroutine is started and breaking out of the loop
+// early leaks nothing.  The set is not copied.  Changes to s during iteration
+// may be reflected in the elements yielded.
+func (s SetM) All() iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		for _, e := range s {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
 // Cardinality returns the number of elements in the set.
 //
 // With the slice type, this is simply len(s).
@@ -101,15 +120,37 @@ type OrderedPair struct {
 
 // Equal satisfies the Element interface, allowing OrderedPair values to be
 // elements of SetMs.
+//
+// Pairs are equal if their A components are equal and their B components are
+// equal, as determined by the Equal methods of the components.  Components
+// thus need not be Go-comparable; they can be sets, for example.  A nil
+// component is equal only to a nil component.
 func (p OrderedPair) Equal(q Element) bool {
 	r, ok := q.(OrderedPair)
-	return ok && p == r
+	return ok && equalOrNil(p.A, r.A) && equalOrNil(p.B, r.B)
+}
+
+// equalOrNil is a.Equal(b), except that nil is equal only to nil.
+func equalOrNil(a, b Element) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(b)
+}
+
+// Hash satisfies the Hasher interface, combining hashes of the pair
+// components.  Components not implementing Hasher, including nil
+// components, contribute a hash of 0.
+func (p OrderedPair) Hash() uint64 {
+	return mix(hash(p.A))*31 + hash(p.B)
 }
 
 // CartesianProduct returns a new set containing the cartesian product of s
 // and t.
 //
 // Elements of the result will have the dynamic type OrderedPair.
+//
+// See SetM.CartesianProductV for a product of any number of sets.
 func (s SetM) CartesianProduct(t SetM) SetM {
 	p := make(SetM, len(s)*len(t))
 	i := 0
@@ -195,7 +236,7 @@ func (r *SetM) DifferenceR(c SetM) {
 
 // Do calls f on each element of s, in random order.
 func (s SetM) Do(f func(Element)) {
-	for _, i := range rand.Perm(len(s)) {
+	for _, i := range randPerm(len(s)) {
 		f(s[i])
 	}
 }
@@ -207,7 +248,7 @@ func (s SetM) Do(f func(Element)) {
 // If f returns false for an element, DoWhile returns false immediately
 // without calling f on any remaining elements.
 func (s SetM) DoWhile(f func(Element) bool) bool {
-	for _, i := range rand.Perm(len(s)) {
+	for _, i := range randPerm(len(s)) {
 		if !f(s[i]) {
 			return false
 		}
@@ -404,7 +445,9 @@ func (s SetM) IsSuperset(t SetM) bool {
 // The channel is unbuffered and the set is not copied.  Changes to s
 // concurrent with channel receives may be reflected in the received values.
 //
-// The channel is closed after all elements are sent.
+// The channel is closed after all elements are sent.  If the receiver stops
+// receiving before then, the sending goroutine is never released.
+// See SetM.All for an iterator without this problem.
 func (s SetM) Iter() <-chan Element {
 	c := make(chan Element)
 	go func() {
@@ -425,7 +468,7 @@ func (s SetM) Iter() <-chan Element {
 // The ok return will be true for each element of s, then false on any
 // call afterwards.
 func (s SetM) IterFunc() func() (e Element, ok bool) {
-	r := rand.Perm(len(s))
+	r := randPerm(len(s))
 	i := 0
 	return func() (Element, bool) {
 		if i >= len(s) {
@@ -446,7 +489,7 @@ func (s SetM) IterFunc() func() (e Element, ok bool) {
 // The channel is closed after all elements are sent.
 func (s SetM) IterBuffered() <-chan Element {
 	c := make(chan Element, len(s))
-	for _, i := range rand.Perm(len(s)) {
+	for _, i := range randPerm(len(s)) {
 		c <- s[i]
 	}
 	close(c)
@@ -463,6 +506,22 @@ func (s SetM) Map(f func(Element) Element) (m SetM) {
 	return
 }
 
+// Pairs returns an iterator over the cartesian product of s and t.
+//
+// This yields the elements of s.CartesianProduct(t) one at a time without
+// constructing the product.
+func (s SetM) Pairs(t SetM) iter.Seq[OrderedPair] {
+	return func(yield func(OrderedPair) bool) {
+		for _, es := range s {
+			for _, et := range t {
+				if !yield(OrderedPair{es, et}) {
+					return
+				}
+			}
+		}
+	}
+}
+
 // Peek returns a random element of s.
 //
 // If s is empty, Peek returns nil, false.
@@ -470,7 +529,7 @@ func (s SetM) Peek() (e Element, ok bool) {
 	if len(s) == 0 {
 		return
 	}
-	return s[rand.Intn(len(s))], true
+	return s[randIntn(len(s))], true
 }
 
 // Pop returns a random element of r and removes it from r.
@@ -483,7 +542,7 @@ func (r *SetM) Pop() (e Element, ok bool) {
 	if len(s) == 0 {
 		return
 	}
-	i := rand.Intn(len(s))
+	i := randIntn(len(s))
 	e = s[i]
 	copy(s[i:], s[i+1:])
 	last := len(s) - 1
@@ -494,6 +553,8 @@ func (r *SetM) Pop() (e Element, ok bool) {
 // PowerSet returns the power set of a set.
 //
 // The power set of s is the set of all possible subsets of s.
+//
+// See SetM.Subsets for an iterator that does not construct the power set.
 func (s SetM) PowerSet() SetM {
 	r := SetM{SetM{}}
 	for _, es := range s {
@@ -545,9 +606,12 @@ func (r *SetM) RemoveIf(f func(Element) bool) (removed bool) {
 }
 
 // String satisfies fmt.Stringer, providing a printable representation of a set.
+//
+// Elements are printed in random order.  See Canonical for a deterministic
+// representation and SetRandSource for reproducible random order.
 func (s SetM) String() string {
 	r := "{"
-	for i, j := range rand.Perm(len(s)) {
+	for i, j := range randPerm(len(s)) {
 		if i > 0 {
 			r += " "
 		}
@@ -556,6 +620,29 @@ func (s SetM) String() string {
 	return r + "}"
 }
 
+// Subsets returns an iterator over the subsets of s.
+//
+// This yields the elements of s.PowerSet() one at a time without
+// constructing the power set.  Each yielded set has its own backing array.
+//
+// See SetM.EnumerateSubsets for control over order, size limits, and
+// cancellation.
+func (s SetM) Subsets() iter.Seq[SetM] {
+	return func(yield func(SetM) bool) {
+		var r func(int, SetM) bool
+		r = func(i int, sub SetM) bool {
+			if i == len(s) {
+				return yield(sub)
+			}
+			// extend sub before yielding it, so a caller modifying a
+			// yielded set cannot affect later subsets.
+			return r(i+1, append(sub[:len(sub):len(sub)], s[i])) &&
+				r(i+1, sub)
+		}
+		r(0, SetM{})
+	}
+}
+
 // SymmetricDifference returns a new set with elements in s or t but not both.
 func (s SetM) SymmetricDifference(t SetM) SetM {
 	d := s.Copy()
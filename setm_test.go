@@ -0,0 +1,152 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"math"
+	"runtime"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func TestAllEarlyBreak(t *testing.T) {
+	s := set.NewSetM(intEle(1), intEle(2), intEle(3))
+	g := runtime.NumGoroutine()
+	for i := 0; i < 100; i++ {
+		for range s.All() {
+			break
+		}
+	}
+	if runtime.NumGoroutine() > g {
+		t.Fatal("goroutines leaked")
+	}
+	var got set.SetM
+	for e := range s.All() {
+		got.Add(e)
+	}
+	if !got.Equal(s) {
+		t.Fatal("All:", got)
+	}
+}
+
+func TestSubsetsM(t *testing.T) {
+	s := set.NewSetM(intEle(1), intEle(2), intEle(3))
+	var got set.SetM
+	for sub := range s.Subsets() {
+		got.Add(sub.Copy())
+		// modifying a yielded subset must not affect later ones
+		sub.Remove(intEle(1))
+	}
+	if !got.Equal(s.PowerSet()) {
+		t.Fatal("Subsets:", got)
+	}
+	n := 0
+	for range s.Subsets() {
+		if n++; n == 3 {
+			break
+		}
+	}
+	if n != 3 {
+		t.Fatal("early break")
+	}
+	full := 0
+	for sub := range s.Subsets() {
+		if !sub.Ok() {
+			t.Fatal("bad subset:", sub)
+		}
+		if len(sub) == 3 {
+			full++
+		}
+	}
+	if full != 1 {
+		t.Fatal("full set yielded", full, "times")
+	}
+}
+
+func TestPairs(t *testing.T) {
+	s := set.NewSetM(intEle(1), intEle(2))
+	u := set.NewSetM(intEle(3), intEle(4), intEle(5))
+	p := s.CartesianProduct(u)
+	n := 0
+	for op := range s.Pairs(u) {
+		if !p.HasElement(op) {
+			t.Fatal("pair not in product:", op)
+		}
+		n++
+	}
+	if n != len(p) {
+		t.Fatal("Pairs:", n)
+	}
+}
+
+func TestOrderedPairEqual(t *testing.T) {
+	// Components that are not Go-comparable.  Comparing these with ==
+	// panics.
+	a := set.OrderedPair{set.SetM{intEle(1), intEle(2)}, set.SetM{}}
+	b := set.OrderedPair{set.SetM{intEle(2), intEle(1)}, set.SetM{}}
+	if !a.Equal(b) || !b.Equal(a) {
+		t.Fatal("pairs of equal sets not equal")
+	}
+	if a.Equal(set.OrderedPair{a.B, a.A}) {
+		t.Fatal("swapped pair equal")
+	}
+	if a.Equal(a.A) {
+		t.Fatal("pair equal to non-pair")
+	}
+	// Component Equal semantics, not ==.
+	n := set.OrderedPair{fEle(math.NaN()), fEle(0)}
+	if !n.Equal(set.OrderedPair{fEle(math.NaN()), fEle(0)}) {
+		t.Fatal("NaN pair not equal")
+	}
+	if n.Equal(set.OrderedPair{fEle(math.NaN()), fEle(math.Copysign(0, -1))}) {
+		t.Fatal("-0 pair equal to 0 pair")
+	}
+	if !set.Reflexive(n) || !set.Symmetric(a, b) || !set.Transitive(a, b, a) {
+		t.Fatal("Equal laws")
+	}
+	// Nil components.
+	z := set.OrderedPair{nil, intEle(1)}
+	if !z.Equal(set.OrderedPair{nil, intEle(1)}) || z.Equal(set.OrderedPair{intEle(1), intEle(1)}) {
+		t.Fatal("nil A")
+	}
+	if (set.OrderedPair{intEle(1), intEle(1)}).Equal(z) || (set.OrderedPair{}).Equal(z) {
+		t.Fatal("nil compared with non-nil")
+	}
+	if !(set.OrderedPair{}).Equal(set.OrderedPair{}) {
+		t.Fatal("nil pair not equal")
+	}
+	if (set.OrderedPair{}).Hash() != 0 || z.Hash() != (set.OrderedPair{nil, intEle(2)}).Hash() {
+		t.Fatal("nil Hash")
+	}
+	// Nested pairs.
+	p := set.OrderedPair{a, set.SetM{b}}
+	q := set.OrderedPair{b, set.SetM{a}}
+	if !p.Equal(q) {
+		t.Fatal("nested pairs not equal")
+	}
+}
+
+func TestCartesianProductSetsOfSets(t *testing.T) {
+	ps := set.NewSetM(intEle(1), intEle(2)).PowerSet()
+	p := ps.CartesianProduct(ps)
+	if !p.Ok() || len(p) != 16 {
+		t.Fatal("product of power sets")
+	}
+	pair := set.OrderedPair{set.SetM{intEle(2), intEle(1)}, set.SetM{}}
+	if !p.HasElement(pair) {
+		t.Fatal("HasElement")
+	}
+	s := set.NewSetM(pair, set.OrderedPair{set.SetM{}, set.SetM{intEle(1), intEle(2)}})
+	if s.Add(set.OrderedPair{set.SetM{intEle(1), intEle(2)}, set.SetM{}}) {
+		t.Fatal("duplicate pair added")
+	}
+	if i := p.Intersect(s); !i.Equal(s) {
+		t.Fatal("Intersect:", i)
+	}
+	h := set.NewSetH(p...)
+	if h.Cardinality() != 16 || !h.HasElement(pair) {
+		t.Fatal("SetH of pairs")
+	}
+}
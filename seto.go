@@ -0,0 +1,571 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+)
+
+// An Ordered is an Element that also defines a strict ordering.
+//
+// Less returns true if the receiver is to be ordered before the argument.
+// For a valid implementation, Less must be a strict weak ordering
+// consistent with Equal:  a.Equal(b) must be true exactly when neither
+// a.Less(b) nor b.Less(a).
+type Ordered interface {
+	Element
+	Less(Element) bool
+}
+
+// SetO is a type implementing the mathematical concept of a set, for elements
+// that are ordered.
+//
+// SetO is a slice of Ordered elements maintained in ascending order.
+// HasElement, Add, and Remove locate elements with a binary search,
+// taking O(log n) comparisons.  Union, Intersect, and the like merge the
+// two ordered slices in linear time.  Iteration is in order.
+//
+// As with Set and SetM, Equal must return false for any pair of elements.
+// Additionally elements must be kept in order.  A SetO constructed as a
+// slice literal should be checked with Ok or built with NewSetO.
+type SetO []Ordered
+
+// NewSetO returns a new set with the given elements.
+//
+// The arguments may be in any order and may contain duplicates.
+func NewSetO(es ...Ordered) SetO {
+	var n SetO
+	n.AddV(es...)
+	return n
+}
+
+// Ok validates that elements are in strictly ascending order.
+//
+// With a valid Less method this also means Equal returns false for all
+// pairs of elements.
+func (s SetO) Ok() bool {
+	for i := 1; i < len(s); i++ {
+		if !s[i-1].Less(s[i]) || s[i-1].Equal(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// search returns the index of the first element of s not less than e.
+func (s SetO) search(e Element) int {
+	return sort.Search(len(s), func(i int) bool { return !s[i].Less(e) })
+}
+
+// cmp returns -1, 0, or +1 as a is less than, equal to, or greater than b.
+func cmp(a, b Ordered) int {
+	switch {
+	case a.Less(b):
+		return -1
+	case b.Less(a):
+		return 1
+	}
+	return 0
+}
+
+// Add adds a single element to a set.
+//
+// Returns true if e was added.  Returns false if e was already present.
+//
+// As with SetM.Add, a new backing array is always allocated, so other
+// copies of the set are not changed.  Use SetO.AddV to add many elements
+// at once.
+//
+// See SetO.AddV for a variadic version.
+func (r *SetO) Add(e Ordered) bool {
+	s := *r
+	i := s.search(e)
+	if i < len(s) && s[i].Equal(e) {
+		return false
+	}
+	n := make(SetO, len(s)+1)
+	copy(n, s[:i])
+	n[i] = e
+	copy(n[i+1:], s[i:])
+	*r = n
+	return true
+}
+
+// AddV adds multiple elements to a set.
+//
+// Returns true if any element was added.  Returns false if all argument
+// elements were already present.
+//
+// The elements are sorted and merged with r, so adding many elements at once
+// is faster than adding them individually.
+func (r *SetO) AddV(es ...Ordered) bool {
+	a := append(SetO{}, es...)
+	sort.SliceStable(a, func(i, j int) bool { return a[i].Less(a[j]) })
+	n := 0
+	for _, e := range a {
+		if n == 0 || !a[n-1].Equal(e) {
+			a[n] = e
+			n++
+		}
+	}
+	u := r.Union(a[:n])
+	if len(u) == len(*r) {
+		return false
+	}
+	*r = u
+	return true
+}
+
+// All returns an iterator over elements of s, in order.
+func (s SetO) All() iter.Seq[Ordered] {
+	return func(yield func(Ordered) bool) {
+		for _, e := range s {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Cardinality returns the number of elements in the set.
+func (s SetO) Cardinality() int { return len(s) }
+
+// CartesianProduct returns the cartesian product of s and t.
+//
+// OrderedPair is not Ordered, so the result is a SetM.  Pairs are listed in
+// lexicographic order.
+func (s SetO) CartesianProduct(t SetO) SetM {
+	p := make(SetM, 0, len(s)*len(t))
+	for _, es := range s {
+		for _, et := range t {
+			p = append(p, OrderedPair{es, et})
+		}
+	}
+	return p
+}
+
+// Clear removes all elements from the set, leaving the empty set.
+func (r *SetO) Clear() { *r = nil }
+
+// Copy returns a copy, or a clone of a set.
+//
+// The returned set is based on a newly allocated slice.  Elements are shared.
+func (s SetO) Copy() SetO { return append(SetO{}, s...) }
+
+// Difference returns a new set containing elements of s not in t.
+func (s SetO) Difference(t SetO) (d SetO) {
+	i, j := 0, 0
+	for i < len(s) && j < len(t) {
+		switch cmp(s[i], t[j]) {
+		case -1:
+			d = append(d, s[i])
+			i++
+		case 1:
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	return append(d, s[i:]...)
+}
+
+// DifferenceR produces a set difference by modifying receiver r to remove
+// elements of c.
+func (r *SetO) DifferenceR(c SetO) {
+	*r = r.Difference(c)
+}
+
+// DifferenceV returns a new set containing elements of s not in any of ts.
+func (s SetO) DifferenceV(ts ...SetO) (d SetO) {
+s:
+	for _, e := range s {
+		for _, t := range ts {
+			if t.HasElement(e) {
+				continue s
+			}
+		}
+		d = append(d, e)
+	}
+	return
+}
+
+// Do calls f on each element of s, in order.
+func (s SetO) Do(f func(Element)) {
+	for _, e := range s {
+		f(e)
+	}
+}
+
+// DoWhile calls f on each element of s, in order, as long as f returns true.
+//
+// DoWhile returns true if f returns true for all elements of s.
+func (s SetO) DoWhile(f func(Element) bool) bool {
+	for _, e := range s {
+		if !f(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal satisfies the Element interface, allowing SetO values to be elements
+// of sets.
+//
+// If the dynamic type of the argument is not SetO or if the sets are not
+// equal, Equal returns false.
+func (s SetO) Equal(e Element) bool {
+	t, ok := e.(SetO)
+	if !ok || len(s) != len(t) {
+		return false
+	}
+	for i, se := range s {
+		if !se.Equal(t[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Flatten flattens a set potentially containing nested sets.
+//
+// Elements of dynamic type SetO are replaced by their elements, recursively.
+func (s SetO) Flatten() (f SetO) {
+	for _, e := range s {
+		if s2, ok := e.(SetO); ok {
+			f.UnionR(s2.Flatten())
+		} else {
+			f.Add(e)
+		}
+	}
+	return
+}
+
+// Filter returns the subset containing elements e of s where f(e) is true.
+func (s SetO) Filter(f func(Element) bool) (r SetO) {
+	for _, e := range s {
+		if f(e) {
+			r = append(r, e)
+		}
+	}
+	return
+}
+
+// HasAll tests whether the given elements are all in the set.
+func (s SetO) HasAll(es ...Element) bool {
+	for _, e := range es {
+		if !s.HasElement(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny tests whether any of the given elements are in the set.
+func (s SetO) HasAny(es ...Element) bool {
+	for _, e := range es {
+		if s.HasElement(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasElement returns true if set s contains element e.
+func (s SetO) HasElement(e Element) bool {
+	i := s.search(e)
+	return i < len(s) && s[i].Equal(e)
+}
+
+// Intersect returns a new set of elements of s also in t.
+func (s SetO) Intersect(t SetO) (r SetO) {
+	i, j := 0, 0
+	for i < len(s) && j < len(t) {
+		switch cmp(s[i], t[j]) {
+		case -1:
+			i++
+		case 1:
+			j++
+		default:
+			r = append(r, s[i])
+			i++
+			j++
+		}
+	}
+	return
+}
+
+// IntersectV returns a new set of elements of s also in all of ts.
+func (s SetO) IntersectV(ts ...SetO) (i SetO) {
+s:
+	for _, e := range s {
+		for _, t := range ts {
+			if !t.HasElement(e) {
+				continue s
+			}
+		}
+		i = append(i, e)
+	}
+	return
+}
+
+// IsEmpty returns true if s is the empty set.
+func (s SetO) IsEmpty() bool { return len(s) == 0 }
+
+// IsProperSubset returns true if s is a proper subset of t.
+func (s SetO) IsProperSubset(t SetO) bool {
+	return len(s) < len(t) && s.IsSubset(t)
+}
+
+// IsSubset returns true if s is a subset of t.
+func (s SetO) IsSubset(t SetO) bool {
+	if len(s) > len(t) {
+		return false
+	}
+	j := 0
+	for _, e := range s {
+		for j < len(t) && t[j].Less(e) {
+			j++
+		}
+		if j == len(t) || !t[j].Equal(e) {
+			return false
+		}
+		j++
+	}
+	return true
+}
+
+// IsSuperset returns true if s is a superset of t.
+func (s SetO) IsSuperset(t SetO) bool {
+	return t.IsSubset(s)
+}
+
+// IterFunc returns a function that iterates over elements of s in order.
+//
+// The set is not copied.  Changes to s concurrent with calls to the returned
+// function may be reflected in the returned values.
+//
+// The ok return will be true for each element of s, then false on any
+// call afterwards.
+func (s SetO) IterFunc() func() (e Element, ok bool) {
+	i := 0
+	return func() (Element, bool) {
+		if i >= len(s) {
+			return nil, false
+		}
+		e := s[i]
+		i++
+		return e, true
+	}
+}
+
+// Less satisfies the Ordered interface, allowing SetO values to be elements
+// of SetO values.
+//
+// Sets are ordered lexicographically by their ordered elements.  A set that
+// is a prefix of another is less than it.  Less returns false if the argument
+// does not have dynamic type SetO.
+func (s SetO) Less(e Element) bool {
+	t, ok := e.(SetO)
+	if !ok {
+		return false
+	}
+	for i := 0; i < len(s) && i < len(t); i++ {
+		if c := cmp(s[i], t[i]); c != 0 {
+			return c < 0
+		}
+	}
+	return len(s) < len(t)
+}
+
+// Map returns the set of distinct values f(e) for all e in s.
+//
+// The cardinality of the result m may be less that the cardinality of s.
+func (s SetO) Map(f func(Ordered) Ordered) SetO {
+	m := make(SetO, len(s))
+	for i, e := range s {
+		m[i] = f(e)
+	}
+	return NewSetO(m...)
+}
+
+// Max returns the greatest element of s.
+//
+// If s is empty, Max returns nil, false.
+func (s SetO) Max() (e Ordered, ok bool) {
+	if len(s) == 0 {
+		return
+	}
+	return s[len(s)-1], true
+}
+
+// Min returns the least element of s.
+//
+// If s is empty, Min returns nil, false.
+func (s SetO) Min() (e Ordered, ok bool) {
+	if len(s) == 0 {
+		return
+	}
+	return s[0], true
+}
+
+// Peek returns a random element of s.
+//
+// If s is empty, Peek returns nil, false.  See SetO.Min and SetO.Max for
+// deterministic alternatives.
+func (s SetO) Peek() (e Ordered, ok bool) {
+	if len(s) == 0 {
+		return
+	}
+	return s[randIntn(len(s))], true
+}
+
+// Pop returns a random element of r and removes it from r.
+//
+// If r is empty, Pop returns nil, false.
+func (r *SetO) Pop() (e Ordered, ok bool) {
+	s := *r
+	if len(s) == 0 {
+		return
+	}
+	i := randIntn(len(s))
+	e = s[i]
+	*r = append(s[:i:i], s[i+1:]...)
+	return e, true
+}
+
+// PowerSet returns the power set of a set.
+//
+// Elements of the result have the dynamic type SetO.  As SetO is itself
+// Ordered, the result is a valid SetO.
+func (s SetO) PowerSet() SetO {
+	p := make(SetO, 0, 1<<uint(len(s)))
+	p = append(p, SetO{})
+	for _, es := range s {
+		for _, ep := range p {
+			ep := ep.(SetO)
+			p = append(p, append(ep[:len(ep):len(ep)], es))
+		}
+	}
+	sort.Slice(p, func(i, j int) bool { return p[i].Less(p[j]) })
+	return p
+}
+
+// Remove removes a single element from a set.
+//
+// Returns true if the element was found and removed.
+// Returns false if the element was not found.
+func (r *SetO) Remove(e Element) bool {
+	s := *r
+	i := s.search(e)
+	if i == len(s) || !s[i].Equal(e) {
+		return false
+	}
+	// allocate new backing array, as with Add
+	*r = append(s[:i:i], s[i+1:]...)
+	return true
+}
+
+// RemoveIf removes all elements where f returns true.
+//
+// Returns true if any element was removed.
+// Returns false if no elements were removed.
+func (r *SetO) RemoveIf(f func(Element) bool) (removed bool) {
+	var k SetO
+	for _, e := range *r {
+		if !f(e) {
+			k = append(k, e)
+		}
+	}
+	if len(k) == len(*r) {
+		return false
+	}
+	*r = k
+	return true
+}
+
+// SetM returns the elements of s as a SetM.
+//
+// The result is a new slice, in order.  Elements are shared.
+func (s SetO) SetM() SetM {
+	m := make(SetM, len(s))
+	for i, e := range s {
+		m[i] = e
+	}
+	return m
+}
+
+// String satisfies fmt.Stringer, providing a printable representation of a set.
+//
+// Elements are listed in order.
+func (s SetO) String() string {
+	r := "{"
+	for i, e := range s {
+		if i > 0 {
+			r += " "
+		}
+		r += fmt.Sprint(e)
+	}
+	return r + "}"
+}
+
+// SymmetricDifference returns a new set with elements in s or t but not both.
+func (s SetO) SymmetricDifference(t SetO) (d SetO) {
+	i, j := 0, 0
+	for i < len(s) && j < len(t) {
+		switch cmp(s[i], t[j]) {
+		case -1:
+			d = append(d, s[i])
+			i++
+		case 1:
+			d = append(d, t[j])
+			j++
+		default:
+			i++
+			j++
+		}
+	}
+	d = append(d, s[i:]...)
+	return append(d, t[j:]...)
+}
+
+// Union returns a new set with elements of s or t.
+//
+// See SetO.UnionR for a version that modifies the receiver.
+func (s SetO) Union(t SetO) SetO {
+	u := make(SetO, 0, len(s)+len(t))
+	i, j := 0, 0
+	for i < len(s) && j < len(t) {
+		switch cmp(s[i], t[j]) {
+		case -1:
+			u = append(u, s[i])
+			i++
+		case 1:
+			u = append(u, t[j])
+			j++
+		default:
+			u = append(u, s[i])
+			i++
+			j++
+		}
+	}
+	u = append(u, s[i:]...)
+	return append(u, t[j:]...)
+}
+
+// UnionR produces a union by modifying receiver r to include elements of t.
+func (r *SetO) UnionR(t SetO) {
+	*r = r.Union(t)
+}
+
+// UnionV returns a new set with elements of s or any of ts.
+func (s SetO) UnionV(ts ...SetO) SetO {
+	u := s.Copy()
+	for _, t := range ts {
+		u = u.Union(t)
+	}
+	return u
+}
@@ -0,0 +1,145 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+// integer type satisfying Ordered
+type oEle int
+
+func (i oEle) Equal(e set.Element) bool {
+	j, ok := e.(oEle)
+	return ok && i == j
+}
+
+func (i oEle) Less(e set.Element) bool {
+	return i < e.(oEle)
+}
+
+func oSet(is ...int) set.SetO {
+	s := make(set.SetO, len(is))
+	for i, x := range is {
+		s[i] = oEle(x)
+	}
+	return s
+}
+
+func TestSetO(t *testing.T) {
+	s := set.NewSetO(oEle(5), oEle(1), oEle(3), oEle(1), oEle(9))
+	if !s.Ok() || !s.Equal(oSet(1, 3, 5, 9)) {
+		t.Fatal("NewSetO:", s)
+	}
+	if !s.Add(oEle(4)) || s.Add(oEle(4)) || !s.Ok() {
+		t.Fatal("Add:", s)
+	}
+	if !s.HasElement(oEle(4)) || s.HasElement(oEle(2)) {
+		t.Fatal("HasElement")
+	}
+	if !s.Remove(oEle(1)) || s.Remove(oEle(1)) || !s.Equal(oSet(3, 4, 5, 9)) {
+		t.Fatal("Remove:", s)
+	}
+	u := oSet(2, 4, 9, 10)
+	if r := s.Union(u); !r.Equal(oSet(2, 3, 4, 5, 9, 10)) {
+		t.Fatal("Union:", r)
+	}
+	if r := s.Intersect(u); !r.Equal(oSet(4, 9)) {
+		t.Fatal("Intersect:", r)
+	}
+	if r := s.Difference(u); !r.Equal(oSet(3, 5)) {
+		t.Fatal("Difference:", r)
+	}
+	if r := s.SymmetricDifference(u); !r.Equal(oSet(2, 3, 5, 10)) {
+		t.Fatal("SymmetricDifference:", r)
+	}
+	if !oSet(4, 9).IsProperSubset(s) || !s.IsSubset(s) || u.IsSubset(s) {
+		t.Fatal("subset")
+	}
+	if m, _ := s.Min(); m != oEle(3) {
+		t.Fatal("Min:", m)
+	}
+	if m, _ := s.Max(); m != oEle(9) {
+		t.Fatal("Max:", m)
+	}
+	if s.String() != "{3 4 5 9}" {
+		t.Fatal("String:", s)
+	}
+	s.RemoveIf(func(e set.Element) bool { return e.(oEle)%2 == 1 })
+	if !s.Equal(oSet(4)) {
+		t.Fatal("RemoveIf:", s)
+	}
+}
+
+func TestSetOPowerSet(t *testing.T) {
+	p := oSet(1, 2, 3).PowerSet()
+	if !p.Ok() || len(p) != 8 {
+		t.Fatal("PowerSet:", p)
+	}
+	if p.String() != "{{} {1} {1 2} {1 2 3} {1 3} {2} {2 3} {3}}" {
+		t.Fatal("PowerSet order:", p)
+	}
+	if !p.HasElement(oSet(1, 3)) || p.HasElement(oSet(3, 1, 2)) {
+		t.Fatal("PowerSet HasElement")
+	}
+}
+
+func TestSetOAlias(t *testing.T) {
+	s := make(set.SetO, 0, 8)
+	s = append(s, oSet(1, 3, 7)...)
+	a := s // a shares the backing array, with spare capacity
+	s.Add(oEle(2))
+	if !a.Equal(oSet(1, 3, 7)) || !s.Equal(oSet(1, 2, 3, 7)) {
+		t.Fatal("Add changed alias:", a, s)
+	}
+	a = s
+	outer := set.SetO{s}
+	s.Remove(oEle(3))
+	if !a.Equal(oSet(1, 2, 3, 7)) || !outer[0].Equal(oSet(1, 2, 3, 7)) {
+		t.Fatal("Remove changed alias:", a, outer)
+	}
+	if !a.HasElement(oEle(7)) || !s.Equal(oSet(1, 2, 7)) {
+		t.Fatal("Remove:", s)
+	}
+	a = s
+	s.RemoveIf(func(e set.Element) bool { return e.(oEle) < 3 })
+	s.Pop()
+	if !a.Equal(oSet(1, 2, 7)) || len(s) != 0 {
+		t.Fatal("RemoveIf, Pop changed alias:", a, s)
+	}
+}
+
+func TestSetOV(t *testing.T) {
+	s := oSet(1, 2, 3, 4, 5)
+	if d := s.DifferenceV(oSet(1), oSet(4, 6)); !d.Equal(oSet(2, 3, 5)) {
+		t.Fatal("DifferenceV:", d)
+	}
+	if i := s.IntersectV(oSet(1, 2, 3), oSet(0, 2, 3)); !i.Equal(oSet(2, 3)) {
+		t.Fatal("IntersectV:", i)
+	}
+	if u := oSet(3).UnionV(oSet(1), oSet(3, 5)); !u.Ok() || !u.Equal(oSet(1, 3, 5)) {
+		t.Fatal("UnionV:", u)
+	}
+	d := s.Copy()
+	d.DifferenceR(oSet(2, 4))
+	if !d.Equal(oSet(1, 3, 5)) || !s.Equal(oSet(1, 2, 3, 4, 5)) {
+		t.Fatal("DifferenceR:", d, s)
+	}
+	f := set.SetO{oEle(1), oSet(2, 4), set.SetO{oSet(3), oEle(5)}}.Flatten()
+	if !f.Ok() || !f.Equal(oSet(1, 2, 3, 4, 5)) {
+		t.Fatal("Flatten:", f)
+	}
+	p := oSet(1, 2).CartesianProduct(oSet(3))
+	if !p.Equal(set.SetM{set.OrderedPair{oEle(1), oEle(3)}, set.OrderedPair{oEle(2), oEle(3)}}) {
+		t.Fatal("CartesianProduct:", p)
+	}
+	if e, ok := s.Peek(); !ok || !s.HasElement(e) {
+		t.Fatal("Peek:", e)
+	}
+	if e, ok := s.Pop(); !ok || s.HasElement(e) || len(s) != 4 || !s.Ok() {
+		t.Fatal("Pop:", e, s)
+	}
+}
@@ -0,0 +1,870 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"fmt"
+	"iter"
+)
+
+// An Equaler is a type with an Equal method taking an argument of the same
+// type.
+//
+// Equaler is the type-parameterized counterpart of Element.  The same rules
+// apply:  Equal must be reflexive, symmetric, and transitive.
+type Equaler[T any] interface {
+	Equal(T) bool
+}
+
+// SetOf is a type-parameterized version of SetM.
+//
+// Elements have static type T so they can be used without type assertions.
+// Like SetM, SetOf is a slice, and like SetM, the order of elements must be
+// considered irrelevant and Equal must return false for any pair of elements.
+//
+// SetOf[T] itself satisfies Equaler[SetOf[T]] so sets of sets can be typed
+// as SetOf[SetOf[T]].
+type SetOf[T Equaler[T]] []T
+
+// NewSetOf returns a new set with the given elements.
+//
+// Duplicate elements are not added.
+func NewSetOf[T Equaler[T]](es ...T) SetOf[T] {
+	var n SetOf[T]
+	for _, e := range es {
+		n.Add(e)
+	}
+	return n
+}
+
+// Ok validates that Equal returns false for all pairs of elements.
+func (s SetOf[T]) Ok() bool {
+	for i, a := range s {
+		for _, b := range s[:i] {
+			if a.Equal(b) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Add adds a single element to a set.
+//
+// Returns true if e was added.  Returns false if e was already present.
+func (r *SetOf[T]) Add(e T) bool {
+	if r.HasElement(e) {
+		return false
+	}
+	// always allocate new backing array, as with SetM.Add
+	s := *r
+	*r = append(s[:len(s):len(s)], e)
+	return true
+}
+
+// AddV adds multiple elements to a set.
+//
+// Returns true if any element was added.  Returns false if all argument
+// elements were already present.
+func (r *SetOf[T]) AddV(es ...T) (added bool) {
+	s := r.Copy()
+	for _, e := range es {
+		if !s.HasElement(e) {
+			s = append(s, e)
+			added = true
+		}
+	}
+	if added {
+		*r = s
+	}
+	return
+}
+
+// All returns an iterator over elements of s.
+func (s SetOf[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, e := range s {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Cardinality returns the number of elements in the set.
+func (s SetOf[T]) Cardinality() int { return len(s) }
+
+// Clear removes all elements from the set, leaving the empty set.
+func (r *SetOf[T]) Clear() { *r = nil }
+
+// Copy returns a copy, or a clone of a set.
+//
+// The returned set is based on a newly allocated slice.  Elements though
+// are shared.  That is, this is a shallow copy.
+func (s SetOf[T]) Copy() SetOf[T] { return append(SetOf[T]{}, s...) }
+
+// Difference returns a new set containing elements of s not in t.
+func (s SetOf[T]) Difference(t SetOf[T]) (d SetOf[T]) {
+	for _, e := range s {
+		if !t.HasElement(e) {
+			d = append(d, e)
+		}
+	}
+	return
+}
+
+// DifferenceV returns a new set containing elements that are in s but not in
+// any of the sets ts.
+func (s SetOf[T]) DifferenceV(ts ...SetOf[T]) (d SetOf[T]) {
+s:
+	for _, e := range s {
+		for _, t := range ts {
+			if t.HasElement(e) {
+				continue s
+			}
+		}
+		d = append(d, e)
+	}
+	return
+}
+
+// DifferenceR modifies receiver r to be the difference r - c.
+func (r *SetOf[T]) DifferenceR(c SetOf[T]) {
+	for _, e := range c {
+		r.Remove(e)
+	}
+}
+
+// Do calls f on each element of s, in random order.
+func (s SetOf[T]) Do(f func(T)) {
+	for _, i := range randPerm(len(s)) {
+		f(s[i])
+	}
+}
+
+// DoWhile calls f on each element of s, in random order, as long as f returns
+// true.
+//
+// DoWhile returns true if f returns true for all elements of s.
+func (s SetOf[T]) DoWhile(f func(T) bool) bool {
+	for _, i := range randPerm(len(s)) {
+		if !f(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if s and t contain the same elements.
+//
+// Equal satisfies Equaler[SetOf[T]].
+func (s SetOf[T]) Equal(t SetOf[T]) bool {
+	return len(s) == len(t) && s.HasAll(t...)
+}
+
+// Filter returns the subset containing elements e of s where f(e) is true.
+func (s SetOf[T]) Filter(f func(T) bool) (r SetOf[T]) {
+	for _, e := range s {
+		if f(e) {
+			r = append(r, e)
+		}
+	}
+	return
+}
+
+// HasAll tests whether the given elements are all in the set.
+func (s SetOf[T]) HasAll(es ...T) bool {
+	for _, e := range es {
+		if !s.HasElement(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny tests whether any of the given elements are in the set.
+func (s SetOf[T]) HasAny(es ...T) bool {
+	for _, e := range es {
+		if s.HasElement(e) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasElement returns true if set s contains element e.
+func (s SetOf[T]) HasElement(e T) bool {
+	for _, ex := range s {
+		if e.Equal(ex) {
+			return true
+		}
+	}
+	return false
+}
+
+// Intersect returns a new set of elements of s also in t.
+func (s SetOf[T]) Intersect(t SetOf[T]) (i SetOf[T]) {
+	for _, e := range s {
+		if t.HasElement(e) {
+			i = append(i, e)
+		}
+	}
+	return
+}
+
+// IntersectV returns a new set of elements of s that are also present in
+// all sets ts.
+func (s SetOf[T]) IntersectV(ts ...SetOf[T]) (i SetOf[T]) {
+s:
+	for _, e := range s {
+		for _, t := range ts {
+			if !t.HasElement(e) {
+				continue s
+			}
+		}
+		i = append(i, e)
+	}
+	return
+}
+
+// IsEmpty returns true if s is the empty set.
+func (s SetOf[T]) IsEmpty() bool { return len(s) == 0 }
+
+// IsProperSubset returns true if s is a proper subset of t.
+func (s SetOf[T]) IsProperSubset(t SetOf[T]) bool {
+	return len(s) < len(t) && t.HasAll(s...)
+}
+
+// IsSubset returns true if s is a subset of t.
+func (s SetOf[T]) IsSubset(t SetOf[T]) bool {
+	return len(s) <= len(t) && t.HasAll(s...)
+}
+
+// IsSuperset returns true if s is a superset of t.
+func (s SetOf[T]) IsSuperset(t SetOf[T]) bool {
+	return t.IsSubset(s)
+}
+
+// IterFunc returns a function that iterates over elements of s in random
+// order.
+//
+// The ok return will be true for each element of s, then false on any
+// call afterwards.
+func (s SetOf[T]) IterFunc() func() (e T, ok bool) {
+	r := randPerm(len(s))
+	i := 0
+	return func() (e T, ok bool) {
+		if i >= len(s) {
+			return
+		}
+		e = s[r[i]]
+		i++
+		return e, true
+	}
+}
+
+// Map returns the set of distinct values f(e) for all e in s.
+//
+// See the function MapOf for a version where the result element type differs.
+func (s SetOf[T]) Map(f func(T) T) (m SetOf[T]) {
+	for _, e := range s {
+		m.Add(f(e))
+	}
+	return
+}
+
+// MapOf returns the set of distinct values f(e) for all e in s.
+//
+// Go methods cannot have type parameters so this is a function rather than
+// a method.
+func MapOf[T Equaler[T], U Equaler[U]](s SetOf[T], f func(T) U) (m SetOf[U]) {
+	for _, e := range s {
+		m.Add(f(e))
+	}
+	return
+}
+
+// Peek returns a random element of s.
+//
+// If s is empty, Peek returns the zero value of T and false.
+func (s SetOf[T]) Peek() (e T, ok bool) {
+	if len(s) == 0 {
+		return
+	}
+	return s[randIntn(len(s))], true
+}
+
+// Pop returns a random element of r and removes it from r.
+//
+// If r is empty, Pop returns the zero value of T and false.
+func (r *SetOf[T]) Pop() (e T, ok bool) {
+	s := *r
+	if len(s) == 0 {
+		return
+	}
+	i := randIntn(len(s))
+	e = s[i]
+	last := len(s) - 1
+	*r = append(s[:i:i], s[i+1:]...)[:last:last]
+	return e, true
+}
+
+// PowerSetOf returns the power set of a set.
+//
+// The power set of s is the set of all possible subsets of s.
+//
+// This is a function rather than a method because a method of SetOf[T]
+// cannot return a SetOf[SetOf[T]].
+func PowerSetOf[T Equaler[T]](s SetOf[T]) SetOf[SetOf[T]] {
+	r := SetOf[SetOf[T]]{SetOf[T]{}}
+	for _, es := range s {
+		var u SetOf[SetOf[T]]
+		for _, er := range r {
+			u = append(u, append(er[:len(er):len(er)], es))
+		}
+		r = append(r, u...)
+	}
+	return r
+}
+
+// Remove removes a single element from a set.
+//
+// Returns true if the element was found and removed.
+// Returns false if the element was not found.
+func (r *SetOf[T]) Remove(e T) bool {
+	for i, ex := range *r {
+		if e.Equal(ex) {
+			s := *r
+			last := len(s) - 1
+			s[i], s[last] = s[last], s[i]
+			*r = s[:last]
+			return true
+		}
+	}
+	return false
+}
+
+// RemoveIf removes all elements where f returns true.
+//
+// Returns true if any element was removed.
+// Returns false if no elements were removed.
+func (r *SetOf[T]) RemoveIf(f func(T) bool) (removed bool) {
+	s := *r
+	for i := 0; i < len(s); {
+		if f(s[i]) {
+			last := len(s) - 1
+			s[i], s[last] = s[last], s[i]
+			s = s[:last]
+			removed = true
+		} else {
+			i++
+		}
+	}
+	*r = s
+	return
+}
+
+// String satisfies fmt.Stringer, providing a printable representation of a set.
+func (s SetOf[T]) String() string {
+	r := "{"
+	for i, j := range randPerm(len(s)) {
+		if i > 0 {
+			r += " "
+		}
+		r += fmt.Sprint(s[j])
+	}
+	return r + "}"
+}
+
+// SymmetricDifference returns a new set with elements in s or t but not both.
+func (s SetOf[T]) SymmetricDifference(t SetOf[T]) SetOf[T] {
+	d := s.Difference(t)
+	for _, e := range t {
+		if !s.HasElement(e) {
+			d = append(d, e)
+		}
+	}
+	return d
+}
+
+// Union returns a new set with elements of s or t.
+func (s SetOf[T]) Union(t SetOf[T]) SetOf[T] {
+	u := s.Copy()
+	u.UnionR(t)
+	return u
+}
+
+// UnionR produces a union by modifying receiver r to include elements of t.
+func (r *SetOf[T]) UnionR(t SetOf[T]) {
+	for _, e := range t {
+		r.Add(e)
+	}
+}
+
+// UnionV returns a new set with elements of s or any of ts.
+func (s SetOf[T]) UnionV(ts ...SetOf[T]) SetOf[T] {
+	u := s.Copy()
+	for _, t := range ts {
+		u.UnionR(t)
+	}
+	return u
+}
+
+// PairOf is a type-parameterized ordered pair.
+type PairOf[T Equaler[T], U Equaler[U]] struct {
+	A T
+	B U
+}
+
+// Equal returns true if the components of p and q are respectively equal.
+func (p PairOf[T, U]) Equal(q PairOf[T, U]) bool {
+	return p.A.Equal(q.A) && p.B.Equal(q.B)
+}
+
+// CartesianProductOf returns a new set containing the cartesian product of
+// s and t.
+func CartesianProductOf[T Equaler[T], U Equaler[U]](s SetOf[T], t SetOf[U]) SetOf[PairOf[T, U]] {
+	p := make(SetOf[PairOf[T, U]], 0, len(s)*len(t))
+	for _, es := range s {
+		for _, et := range t {
+			p = append(p, PairOf[T, U]{es, et})
+		}
+	}
+	return p
+}
+
+// Elem wraps a value of an Equaler type to satisfy the Element interface.
+//
+// Elem is used by SetOf.SetM to place values of type T in a SetM.
+// Two Elem values are equal if they wrap equal values of the same type T.
+type Elem[T Equaler[T]] struct {
+	V T
+}
+
+// Equal satisfies the Element interface.
+func (x Elem[T]) Equal(e Element) bool {
+	y, ok := e.(Elem[T])
+	return ok && x.V.Equal(y.V)
+}
+
+// String satisfies fmt.Stringer, printing the wrapped value.
+func (x Elem[T]) String() string { return fmt.Sprint(x.V) }
+
+// SetM returns the elements of s as a SetM.
+//
+// Elements are wrapped as Elem[T] values, except that elements of a
+// SetOf[Element] are stored directly.
+func (s SetOf[T]) SetM() SetM {
+	if es, ok := any(s).(SetOf[Element]); ok {
+		return append(SetM{}, es...)
+	}
+	m := make(SetM, len(s))
+	for i, e := range s {
+		m[i] = Elem[T]{e}
+	}
+	return m
+}
+
+// SetOfFromSetM returns the elements of s as a SetOf[T].
+//
+// Elements of s must either have dynamic type Elem[T], as produced by
+// SetOf.SetM, or be directly assignable to T.  If any element is neither,
+// SetOfFromSetM returns nil, false.
+func SetOfFromSetM[T Equaler[T]](s SetM) (SetOf[T], bool) {
+	r := make(SetOf[T], len(s))
+	for i, e := range s {
+		switch x := e.(type) {
+		case Elem[T]:
+			r[i] = x.V
+		case T:
+			r[i] = x
+		default:
+			return nil, false
+		}
+	}
+	return r, true
+}
+
+// SetC is a type-parameterized set for Go-comparable element types.
+//
+// SetC is a map so elements are compared with Go ==, not an Equal method,
+// and operations have the O(1) performance of maps.  Methods that modify
+// a SetC have pointer receivers so that the zero value, a nil map, is an
+// empty set ready to use.
+//
+// SetC[T] satisfies Equaler[SetC[T]] so sets of SetC can be typed as
+// SetOf[SetC[T]].
+type SetC[T comparable] map[T]struct{}
+
+// NewSetC returns a new set with the given elements.
+func NewSetC[T comparable](es ...T) SetC[T] {
+	n := make(SetC[T], len(es))
+	for _, e := range es {
+		n[e] = struct{}{}
+	}
+	return n
+}
+
+// Add adds a single element to a set.
+//
+// Returns true if e was added.  Returns false if e was already present.
+func (r *SetC[T]) Add(e T) bool {
+	if *r == nil {
+		*r = SetC[T]{}
+	}
+	if _, ok := (*r)[e]; ok {
+		return false
+	}
+	(*r)[e] = struct{}{}
+	return true
+}
+
+// AddV adds multiple elements to a set.
+//
+// Returns true if any element was added.  Returns false if all argument
+// elements were already present.
+func (r *SetC[T]) AddV(es ...T) (added bool) {
+	for _, e := range es {
+		if r.Add(e) {
+			added = true
+		}
+	}
+	return
+}
+
+// All returns an iterator over elements of s, in no particular order.
+func (s SetC[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for e := range s {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Cardinality returns the number of elements in the set.
+func (s SetC[T]) Cardinality() int { return len(s) }
+
+// Clear removes all elements from the set, leaving the empty set.
+func (r *SetC[T]) Clear() { *r = nil }
+
+// Copy returns a copy, or a clone of a set.
+func (s SetC[T]) Copy() SetC[T] {
+	c := make(SetC[T], len(s))
+	for e := range s {
+		c[e] = struct{}{}
+	}
+	return c
+}
+
+// Difference returns a new set containing elements of s not in t.
+func (s SetC[T]) Difference(t SetC[T]) SetC[T] {
+	return s.DifferenceV(t)
+}
+
+// DifferenceV returns a new set containing elements that are in s but not in
+// any of the sets ts.
+func (s SetC[T]) DifferenceV(ts ...SetC[T]) SetC[T] {
+	d := SetC[T]{}
+s:
+	for e := range s {
+		for _, t := range ts {
+			if _, ok := t[e]; ok {
+				continue s
+			}
+		}
+		d[e] = struct{}{}
+	}
+	return d
+}
+
+// DifferenceR modifies receiver r to be the difference r - c.
+func (r *SetC[T]) DifferenceR(c SetC[T]) {
+	for e := range c {
+		delete(*r, e)
+	}
+}
+
+// Do calls f on each element of s, in random order.
+func (s SetC[T]) Do(f func(T)) {
+	for e := range s {
+		f(e)
+	}
+}
+
+// DoWhile calls f on each element of s, in random order, as long as f returns
+// true.
+//
+// DoWhile returns true if f returns true for all elements of s.
+func (s SetC[T]) DoWhile(f func(T) bool) bool {
+	for e := range s {
+		if !f(e) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if s and t contain the same elements.
+//
+// Equal satisfies Equaler[SetC[T]].
+func (s SetC[T]) Equal(t SetC[T]) bool {
+	return len(s) == len(t) && s.IsSubset(t)
+}
+
+// Filter returns the subset containing elements e of s where f(e) is true.
+func (s SetC[T]) Filter(f func(T) bool) SetC[T] {
+	r := SetC[T]{}
+	for e := range s {
+		if f(e) {
+			r[e] = struct{}{}
+		}
+	}
+	return r
+}
+
+// HasAll tests whether the given elements are all in the set.
+func (s SetC[T]) HasAll(es ...T) bool {
+	for _, e := range es {
+		if _, ok := s[e]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// HasAny tests whether any of the given elements are in the set.
+func (s SetC[T]) HasAny(es ...T) bool {
+	for _, e := range es {
+		if _, ok := s[e]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// HasElement returns true if set s contains element e.
+func (s SetC[T]) HasElement(e T) bool {
+	_, ok := s[e]
+	return ok
+}
+
+// Intersect returns a new set of elements of s also in t.
+func (s SetC[T]) Intersect(t SetC[T]) SetC[T] {
+	if len(t) < len(s) {
+		s, t = t, s
+	}
+	return s.IntersectV(t)
+}
+
+// IntersectV returns a new set of elements of s that are also present in
+// all sets ts.
+func (s SetC[T]) IntersectV(ts ...SetC[T]) SetC[T] {
+	i := SetC[T]{}
+s:
+	for e := range s {
+		for _, t := range ts {
+			if _, ok := t[e]; !ok {
+				continue s
+			}
+		}
+		i[e] = struct{}{}
+	}
+	return i
+}
+
+// IsEmpty returns true if s is the empty set.
+func (s SetC[T]) IsEmpty() bool { return len(s) == 0 }
+
+// IsProperSubset returns true if s is a proper subset of t.
+func (s SetC[T]) IsProperSubset(t SetC[T]) bool {
+	return len(s) < len(t) && s.IsSubset(t)
+}
+
+// IsSubset returns true if s is a subset of t.
+func (s SetC[T]) IsSubset(t SetC[T]) bool {
+	if len(s) > len(t) {
+		return false
+	}
+	for e := range s {
+		if _, ok := t[e]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if s is a superset of t.
+func (s SetC[T]) IsSuperset(t SetC[T]) bool {
+	return t.IsSubset(s)
+}
+
+// Map returns the set of distinct values f(e) for all e in s.
+func (s SetC[T]) Map(f func(T) T) SetC[T] {
+	m := make(SetC[T], len(s))
+	for e := range s {
+		m[f(e)] = struct{}{}
+	}
+	return m
+}
+
+// Peek returns an arbitrary element of s.
+//
+// If s is empty, Peek returns the zero value of T and false.
+func (s SetC[T]) Peek() (e T, ok bool) {
+	for e = range s {
+		return e, true
+	}
+	return
+}
+
+// Pop returns an arbitrary element of r and removes it from r.
+//
+// If r is empty, Pop returns the zero value of T and false.
+func (r *SetC[T]) Pop() (e T, ok bool) {
+	if e, ok = r.Peek(); ok {
+		delete(*r, e)
+	}
+	return
+}
+
+// PowerSet returns the power set of a set.
+//
+// As SetC values are maps and not Go-comparable, the result is a SetOf.
+func (s SetC[T]) PowerSet() SetOf[SetC[T]] {
+	r := SetOf[SetC[T]]{SetC[T]{}}
+	for e := range s {
+		for _, er := range r {
+			u := er.Copy()
+			u[e] = struct{}{}
+			r = append(r, u)
+		}
+	}
+	return r
+}
+
+// Remove removes a single element from a set.
+//
+// Returns true if the element was found and removed.
+// Returns false if the element was not found.
+func (r *SetC[T]) Remove(e T) bool {
+	if _, ok := (*r)[e]; !ok {
+		return false
+	}
+	delete(*r, e)
+	return true
+}
+
+// RemoveIf removes all elements where f returns true.
+//
+// Returns true if any element was removed.
+// Returns false if no elements were removed.
+func (r *SetC[T]) RemoveIf(f func(T) bool) (removed bool) {
+	for e := range *r {
+		if f(e) {
+			delete(*r, e)
+			removed = true
+		}
+	}
+	return
+}
+
+// String satisfies fmt.Stringer, providing a printable representation of a set.
+func (s SetC[T]) String() string {
+	r := "{"
+	first := true
+	for e := range s {
+		if !first {
+			r += " "
+		}
+		first = false
+		r += fmt.Sprint(e)
+	}
+	return r + "}"
+}
+
+// SymmetricDifference returns a new set with elements in s or t but not both.
+func (s SetC[T]) SymmetricDifference(t SetC[T]) SetC[T] {
+	d := s.Difference(t)
+	for e := range t {
+		if _, ok := s[e]; !ok {
+			d[e] = struct{}{}
+		}
+	}
+	return d
+}
+
+// Union returns a new set with elements of s or t.
+func (s SetC[T]) Union(t SetC[T]) SetC[T] {
+	return s.UnionV(t)
+}
+
+// UnionR produces a union by modifying receiver r to include elements of t.
+func (r *SetC[T]) UnionR(t SetC[T]) {
+	if *r == nil {
+		*r = SetC[T]{}
+	}
+	for e := range t {
+		(*r)[e] = struct{}{}
+	}
+}
+
+// UnionV returns a new set with elements of s or any of ts.
+func (s SetC[T]) UnionV(ts ...SetC[T]) SetC[T] {
+	u := s.Copy()
+	for _, t := range ts {
+		u.UnionR(t)
+	}
+	return u
+}
+
+// Comparable wraps a value of a Go-comparable type to satisfy the Element
+// interface.  Two Comparable values are equal if they wrap == values of the
+// same type T.
+type Comparable[T comparable] struct {
+	V T
+}
+
+// Equal satisfies the Element interface.
+func (x Comparable[T]) Equal(e Element) bool {
+	y, ok := e.(Comparable[T])
+	return ok && x.V == y.V
+}
+
+// String satisfies fmt.Stringer, printing the wrapped value.
+func (x Comparable[T]) String() string { return fmt.Sprint(x.V) }
+
+// SetM returns the elements of s as a SetM.
+//
+// Elements are wrapped as Comparable[T] values so that SetM equality is the
+// same Go == used by SetC.
+func (s SetC[T]) SetM() SetM {
+	m := make(SetM, 0, len(s))
+	for e := range s {
+		m = append(m, Comparable[T]{e})
+	}
+	return m
+}
+
+// SetCFromSetM returns the elements of s as a SetC[T].
+//
+// Elements of s must either have dynamic type Comparable[T], as produced by
+// SetC.SetM, or be directly assignable to T.  If any element is neither,
+// SetCFromSetM returns nil, false.  Elements distinct under Equal but equal
+// under Go == are merged.
+func SetCFromSetM[T comparable](s SetM) (SetC[T], bool) {
+	r := make(SetC[T], len(s))
+	for _, e := range s {
+		switch x := e.(type) {
+		case Comparable[T]:
+			r[x.V] = struct{}{}
+		case T:
+			r[x] = struct{}{}
+		default:
+			return nil, false
+		}
+	}
+	return r, true
+}
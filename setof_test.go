@@ -0,0 +1,95 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+// string type equal ignoring case, satisfying set.Equaler
+type foldStr string
+
+func (s foldStr) Equal(t foldStr) bool { return strings.EqualFold(string(s), string(t)) }
+
+func TestSetOf(t *testing.T) {
+	s := set.NewSetOf[foldStr]("a", "B", "A", "c")
+	if !s.Ok() || len(s) != 3 {
+		t.Fatal("NewSetOf:", s)
+	}
+	u := set.NewSetOf[foldStr]("b", "d")
+	if r := s.Union(u); len(r) != 4 || !r.HasElement("D") {
+		t.Fatal("Union:", r)
+	}
+	if r := s.Intersect(u); !r.Equal(set.SetOf[foldStr]{"b"}) {
+		t.Fatal("Intersect:", r)
+	}
+	if r := s.SymmetricDifference(u); !r.Equal(set.SetOf[foldStr]{"a", "c", "d"}) {
+		t.Fatal("SymmetricDifference:", r)
+	}
+	ps := set.PowerSetOf(s)
+	if !ps.Ok() || len(ps) != 8 || !ps.HasElement(set.SetOf[foldStr]{"C", "A"}) {
+		t.Fatal("PowerSet:", ps)
+	}
+	p := set.CartesianProductOf(s, u)
+	if !p.Ok() || len(p) != 6 {
+		t.Fatal("CartesianProductOf:", p)
+	}
+	m := set.MapOf(s, func(x foldStr) set.SetOf[foldStr] { return set.SetOf[foldStr]{x} })
+	if len(m) != 3 || !m[0].HasElement(s[0]) {
+		t.Fatal("MapOf:", m)
+	}
+}
+
+func TestSetOfSetM(t *testing.T) {
+	s := set.NewSetOf[foldStr]("a", "b")
+	m := s.SetM()
+	if !m.Ok() || !m.HasElement(set.Elem[foldStr]{"A"}) {
+		t.Fatal("SetM:", m)
+	}
+	r, ok := set.SetOfFromSetM[foldStr](m)
+	if !ok || !r.Equal(s) {
+		t.Fatal("SetOfFromSetM:", r)
+	}
+	if _, ok := set.SetOfFromSetM[foldStr](set.SetM{intEle(1)}); ok {
+		t.Fatal("SetOfFromSetM accepted intEle")
+	}
+	// SetOf[set.Element] stores elements directly.
+	e := set.NewSetOf[set.Element](intEle(1), intEle(2), intEle(1))
+	if m := e.SetM(); !m.Equal(set.SetM{intEle(2), intEle(1)}) {
+		t.Fatal("SetOf[Element].SetM:", m)
+	}
+}
+
+func TestSetC(t *testing.T) {
+	var s set.SetC[int]
+	if !s.Add(1) || s.Add(1) || !s.AddV(2, 3) {
+		t.Fatal("Add")
+	}
+	u := set.NewSetC(3, 4)
+	if r := s.Union(u); !r.Equal(set.NewSetC(1, 2, 3, 4)) {
+		t.Fatal("Union:", r)
+	}
+	if r := s.Intersect(u); !r.Equal(set.NewSetC(3)) {
+		t.Fatal("Intersect:", r)
+	}
+	if r := s.SymmetricDifference(u); !r.Equal(set.NewSetC(1, 2, 4)) {
+		t.Fatal("SymmetricDifference:", r)
+	}
+	if !set.NewSetC(1).IsProperSubset(s) || !s.IsSubset(s) || s.IsProperSubset(s) {
+		t.Fatal("subset")
+	}
+	if ps := s.PowerSet(); !ps.Ok() || len(ps) != 8 {
+		t.Fatal("PowerSet:", ps)
+	}
+	m := s.SetM()
+	if !m.Ok() || len(m) != 3 || !m.HasElement(set.Comparable[int]{2}) {
+		t.Fatal("SetM:", m)
+	}
+	if r, ok := set.SetCFromSetM[int](m); !ok || !r.Equal(s) {
+		t.Fatal("SetCFromSetM:", r)
+	}
+}
@@ -0,0 +1,508 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"iter"
+	"math/bits"
+)
+
+// SetP is a persistent, immutable set.
+//
+// A SetP value never changes.  Add and Remove return new versions, which
+// share most of their structure with the original.  Keeping many versions
+// of a large set thus costs little more than keeping one.
+//
+// SetP is a hash array mapped trie over element hashes.  Elements should
+// implement Hasher.  Elements with equal hashes, including all elements not
+// implementing Hasher, are kept in lists and compared with Equal.
+//
+// Union, Intersect, and Difference skip over subtrees shared by their
+// operands, so operations on versions derived from one another are fast.
+//
+// The zero value is an empty set.
+type SetP struct {
+	root *hamtNode
+}
+
+const (
+	hamtBits = 5
+	hamtMask = 1<<hamtBits - 1
+)
+
+// hamtNode is an interior node of the trie.  Bit i of bitmap is set if the
+// node has an entry for hash digit i.  Entries are in order of digit.
+//
+// Nodes are kept in a canonical form:  a subtree exists only where two or
+// more distinct hashes share a prefix.  Thus equal sets have the same shape.
+type hamtNode struct {
+	bitmap  uint32
+	entries []hamtEntry
+	size    int // number of elements in this subtree
+}
+
+// hamtEntry is either a subtree or a leaf.
+type hamtEntry struct {
+	sub   *hamtNode // subtree if non-nil, otherwise a leaf
+	h     uint64    // leaf: hash of elements
+	elems SetM      // leaf: elements with hash h
+}
+
+var emptyNode = &hamtNode{}
+
+func (x hamtEntry) size() int {
+	if x.sub != nil {
+		return x.sub.size
+	}
+	return len(x.elems)
+}
+
+// has returns true if the entry contains e, with hash h.
+func (x hamtEntry) has(shift uint, h uint64, e Element) bool {
+	if x.sub != nil {
+		return x.sub.has(shift, h, e)
+	}
+	return x.h == h && x.elems.HasElement(e)
+}
+
+// slot returns the bitmap bit for the hash digit of h at shift.
+func slot(h uint64, shift uint) uint32 {
+	return 1 << (h >> shift & hamtMask)
+}
+
+// pos returns the index in n.entries for bit.
+func (n *hamtNode) pos(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// with returns a copy of n with the entry for bit set to x.
+func (n *hamtNode) with(bit uint32, x hamtEntry) *hamtNode {
+	p := n.pos(bit)
+	m := &hamtNode{bitmap: n.bitmap | bit}
+	if n.bitmap&bit != 0 {
+		m.entries = append([]hamtEntry{}, n.entries...)
+		m.entries[p] = x
+	} else {
+		m.entries = make([]hamtEntry, len(n.entries)+1)
+		copy(m.entries, n.entries[:p])
+		m.entries[p] = x
+		copy(m.entries[p+1:], n.entries[p:])
+	}
+	for _, y := range m.entries {
+		m.size += y.size()
+	}
+	return m
+}
+
+// without returns a copy of n with no entry for bit.
+func (n *hamtNode) without(bit uint32) *hamtNode {
+	p := n.pos(bit)
+	m := &hamtNode{
+		bitmap:  n.bitmap &^ bit,
+		entries: append(n.entries[:p:p], n.entries[p+1:]...),
+		size:    n.size - n.entries[p].size(),
+	}
+	return m
+}
+
+// entryOf returns the entry a parent should hold for subtree n, maintaining
+// canonical form.  ok is false if n is empty.
+func entryOf(n *hamtNode) (x hamtEntry, ok bool) {
+	switch {
+	case n.size == 0:
+		return x, false
+	case len(n.entries) == 1 && n.entries[0].sub == nil:
+		return n.entries[0], true
+	}
+	return hamtEntry{sub: n}, true
+}
+
+func (n *hamtNode) has(shift uint, h uint64, e Element) bool {
+	bit := slot(h, shift)
+	if n.bitmap&bit == 0 {
+		return false
+	}
+	return n.entries[n.pos(bit)].has(shift+hamtBits, h, e)
+}
+
+// insert returns n with e added, and whether e was added.  If e was already
+// present, n itself is returned.
+func (n *hamtNode) insert(shift uint, h uint64, e Element) (*hamtNode, bool) {
+	bit := slot(h, shift)
+	if n.bitmap&bit == 0 {
+		return n.with(bit, hamtEntry{h: h, elems: SetM{e}}), true
+	}
+	x := n.entries[n.pos(bit)]
+	switch {
+	case x.sub != nil:
+		sub, ok := x.sub.insert(shift+hamtBits, h, e)
+		if !ok {
+			return n, false
+		}
+		return n.with(bit, hamtEntry{sub: sub}), true
+	case x.h == h:
+		if x.elems.HasElement(e) {
+			return n, false
+		}
+		es := append(x.elems[:len(x.elems):len(x.elems)], e)
+		return n.with(bit, hamtEntry{h: h, elems: es}), true
+	}
+	leaf := hamtEntry{h: h, elems: SetM{e}}
+	return n.with(bit, hamtEntry{sub: merge(shift+hamtBits, x, leaf)}), true
+}
+
+// merge returns a subtree holding leaves a and b, which have different
+// hashes.
+func merge(shift uint, a, b hamtEntry) *hamtNode {
+	ba, bb := slot(a.h, shift), slot(b.h, shift)
+	n := &hamtNode{bitmap: ba | bb, size: len(a.elems) + len(b.elems)}
+	switch {
+	case ba == bb:
+		n.entries = []hamtEntry{{sub: merge(shift+hamtBits, a, b)}}
+	case ba < bb:
+		n.entries = []hamtEntry{a, b}
+	default:
+		n.entries = []hamtEntry{b, a}
+	}
+	return n
+}
+
+// remove returns n with e removed, and whether e was removed.  If e was not
+// present, n itself is returned.
+func (n *hamtNode) remove(shift uint, h uint64, e Element) (*hamtNode, bool) {
+	bit := slot(h, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+	x := n.entries[n.pos(bit)]
+	var y hamtEntry
+	var keep bool
+	switch {
+	case x.sub != nil:
+		sub, ok := x.sub.remove(shift+hamtBits, h, e)
+		if !ok {
+			return n, false
+		}
+		y, keep = entryOf(sub)
+	case x.h == h:
+		es := x.elems.Copy()
+		if !es.Remove(e) {
+			return n, false
+		}
+		y, keep = hamtEntry{h: h, elems: es}, len(es) > 0
+	default:
+		return n, false
+	}
+	if !keep {
+		return n.without(bit), true
+	}
+	return n.with(bit, y), true
+}
+
+// insertLeaf returns n with all elements of leaf x added.
+func (n *hamtNode) insertLeaf(shift uint, x hamtEntry) *hamtNode {
+	for _, e := range x.elems {
+		n, _ = n.insert(shift, x.h, e)
+	}
+	return n
+}
+
+func union(a, b *hamtNode, shift uint) *hamtNode {
+	if a == b || b.size == 0 {
+		return a
+	}
+	if a.size == 0 {
+		return b
+	}
+	n := &hamtNode{bitmap: a.bitmap | b.bitmap}
+	for bm := n.bitmap; bm != 0; bm &= bm - 1 {
+		bit := bm & -bm
+		var x hamtEntry
+		switch {
+		case a.bitmap&bit == 0:
+			x = b.entries[b.pos(bit)]
+		case b.bitmap&bit == 0:
+			x = a.entries[a.pos(bit)]
+		default:
+			x = unionEntry(a.entries[a.pos(bit)], b.entries[b.pos(bit)], shift+hamtBits)
+		}
+		n.entries = append(n.entries, x)
+		n.size += x.size()
+	}
+	if n.size == a.size {
+		return a // b added nothing
+	}
+	return n
+}
+
+func unionEntry(x, y hamtEntry, shift uint) hamtEntry {
+	switch {
+	case x.sub != nil && y.sub != nil:
+		return hamtEntry{sub: union(x.sub, y.sub, shift)}
+	case x.sub != nil:
+		return hamtEntry{sub: x.sub.insertLeaf(shift, y)}
+	case y.sub != nil:
+		return hamtEntry{sub: y.sub.insertLeaf(shift, x)}
+	case x.h == y.h:
+		return hamtEntry{h: x.h, elems: x.elems.Union(y.elems)}
+	}
+	return hamtEntry{sub: merge(shift, x, y)}
+}
+
+func intersect(a, b *hamtNode, shift uint) *hamtNode {
+	if a == b {
+		return a
+	}
+	n := &hamtNode{}
+	for bm := a.bitmap & b.bitmap; bm != 0; bm &= bm - 1 {
+		bit := bm & -bm
+		x := a.entries[a.pos(bit)]
+		y := b.entries[b.pos(bit)]
+		var z hamtEntry
+		var ok bool
+		switch {
+		case x.sub != nil && y.sub != nil:
+			z, ok = entryOf(intersect(x.sub, y.sub, shift+hamtBits))
+		case x.sub == nil:
+			z, ok = filterLeaf(x, y, shift+hamtBits, true)
+		default:
+			z, ok = filterLeaf(y, x, shift+hamtBits, true)
+		}
+		if ok {
+			n.bitmap |= bit
+			n.entries = append(n.entries, z)
+			n.size += z.size()
+		}
+	}
+	if n.size == a.size {
+		return a
+	}
+	return n
+}
+
+func difference(a, b *hamtNode, shift uint) *hamtNode {
+	if a == b {
+		return emptyNode
+	}
+	n := &hamtNode{}
+	for bm := a.bitmap; bm != 0; bm &= bm - 1 {
+		bit := bm & -bm
+		x := a.entries[a.pos(bit)]
+		z, ok := x, true
+		if b.bitmap&bit != 0 {
+			y := b.entries[b.pos(bit)]
+			switch {
+			case x.sub == nil:
+				z, ok = filterLeaf(x, y, shift+hamtBits, false)
+			case y.sub == nil:
+				sub := x.sub
+				for _, e := range y.elems {
+					sub, _ = sub.remove(shift+hamtBits, y.h, e)
+				}
+				z, ok = entryOf(sub)
+			default:
+				z, ok = entryOf(difference(x.sub, y.sub, shift+hamtBits))
+			}
+		}
+		if ok {
+			n.bitmap |= bit
+			n.entries = append(n.entries, z)
+			n.size += z.size()
+		}
+	}
+	if n.size == a.size {
+		return a
+	}
+	return n
+}
+
+// filterLeaf returns the leaf with elements of leaf x that are (if in is
+// true) or are not (if in is false) in entry y.
+func filterLeaf(x, y hamtEntry, shift uint, in bool) (hamtEntry, bool) {
+	var es SetM
+	for _, e := range x.elems {
+		if y.has(shift, x.h, e) == in {
+			es = append(es, e)
+		}
+	}
+	if len(es) == len(x.elems) {
+		return x, true
+	}
+	return hamtEntry{h: x.h, elems: es}, len(es) > 0
+}
+
+func equalNode(a, b *hamtNode) bool {
+	if a == b {
+		return true
+	}
+	if a.bitmap != b.bitmap || a.size != b.size {
+		return false
+	}
+	for i, x := range a.entries {
+		y := b.entries[i]
+		switch {
+		case x.sub != nil && y.sub != nil:
+			if !equalNode(x.sub, y.sub) {
+				return false
+			}
+		case x.sub != nil || y.sub != nil:
+			return false
+		case x.h != y.h || !x.elems.Equal(y.elems):
+			return false
+		}
+	}
+	return true
+}
+
+func (n *hamtNode) all(yield func(Element) bool) bool {
+	for _, x := range n.entries {
+		if x.sub != nil {
+			if !x.sub.all(yield) {
+				return false
+			}
+			continue
+		}
+		for _, e := range x.elems {
+			if !yield(e) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func (s SetP) node() *hamtNode {
+	if s.root == nil {
+		return emptyNode
+	}
+	return s.root
+}
+
+// NewSetP returns a new set with the given elements.
+func NewSetP(es ...Element) SetP {
+	n := emptyNode
+	for _, e := range es {
+		n, _ = n.insert(0, hash(e), e)
+	}
+	return SetP{n}
+}
+
+// Add returns a set with the elements of s and also e.
+//
+// If e is already in s, s itself is returned.
+func (s SetP) Add(e Element) SetP {
+	n, _ := s.node().insert(0, hash(e), e)
+	return SetP{n}
+}
+
+// All returns an iterator over elements of s, in order of hash.
+func (s SetP) All() iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		s.node().all(yield)
+	}
+}
+
+// Cardinality returns the number of elements in the set.
+func (s SetP) Cardinality() int { return s.node().size }
+
+// Difference returns the set of elements of s not in t.
+func (s SetP) Difference(t SetP) SetP {
+	return SetP{difference(s.node(), t.node(), 0)}
+}
+
+// Equal satisfies the Element interface, allowing SetP values to be elements
+// of sets.
+//
+// If the dynamic type of the argument is not SetP or if the sets are not
+// equal, Equal returns false.
+func (s SetP) Equal(e Element) bool {
+	t, ok := e.(SetP)
+	return ok && equalNode(s.node(), t.node())
+}
+
+// Hash satisfies the Hasher interface, allowing SetP values to be elements
+// of SetP values efficiently.
+func (s SetP) Hash() (h uint64) {
+	for e := range s.All() {
+		h += mix(hash(e))
+	}
+	return
+}
+
+// HasElement returns true if set s contains element e.
+func (s SetP) HasElement(e Element) bool {
+	return s.node().has(0, hash(e), e)
+}
+
+// Intersect returns the set of elements of s also in t.
+func (s SetP) Intersect(t SetP) SetP {
+	return SetP{intersect(s.node(), t.node(), 0)}
+}
+
+// IsEmpty returns true if s is the empty set.
+func (s SetP) IsEmpty() bool { return s.node().size == 0 }
+
+// Ok validates that each element is stored under its hash and that Equal
+// returns false for all pairs of elements.
+func (s SetP) Ok() bool {
+	var ok func(*hamtNode, uint, uint64) bool
+	ok = func(n *hamtNode, shift uint, prefix uint64) bool {
+		size := 0
+		for i, x := range n.entries {
+			// recover the hash digit for entry i from the bitmap
+			bm := n.bitmap
+			for j := 0; j < i; j++ {
+				bm &= bm - 1
+			}
+			d := uint64(bits.TrailingZeros32(bm))
+			p := prefix | d<<shift
+			if x.sub != nil {
+				if !ok(x.sub, shift+hamtBits, p) {
+					return false
+				}
+			} else {
+				mask := uint64(1)<<(shift+hamtBits) - 1
+				if shift+hamtBits >= 64 {
+					mask = ^uint64(0)
+				}
+				if len(x.elems) == 0 || x.h&mask != p || !x.elems.Ok() {
+					return false
+				}
+				for _, e := range x.elems {
+					if hash(e) != x.h {
+						return false
+					}
+				}
+			}
+			size += x.size()
+		}
+		return size == n.size && bits.OnesCount32(n.bitmap) == len(n.entries)
+	}
+	return ok(s.node(), 0, 0)
+}
+
+// Remove returns a set with the elements of s except e.
+//
+// If e is not in s, s itself is returned.
+func (s SetP) Remove(e Element) SetP {
+	n, _ := s.node().remove(0, hash(e), e)
+	return SetP{n}
+}
+
+// SetM returns the elements of s as a new SetM.
+func (s SetP) SetM() SetM {
+	m := make(SetM, 0, s.Cardinality())
+	for e := range s.All() {
+		m = append(m, e)
+	}
+	return m
+}
+
+// String satisfies fmt.Stringer, providing a printable representation of a set.
+func (s SetP) String() string { return s.SetM().String() }
+
+// Union returns the set of elements in s or t.
+func (s SetP) Union(t SetP) SetP {
+	return SetP{union(s.node(), t.node(), 0)}
+}
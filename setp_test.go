@@ -0,0 +1,111 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+// collide is a Hasher with many hash collisions and hashes that differ only
+// in high bits, to exercise collision lists and deep tries.
+type collide int
+
+func (i collide) Equal(e set.Element) bool {
+	j, ok := e.(collide)
+	return ok && i == j
+}
+
+func (i collide) Hash() uint64 { return uint64(i%5) << 60 }
+
+func TestSetP(t *testing.T) {
+	for _, mk := range []func(int) set.Element{
+		func(i int) set.Element { return wEle(i) },
+		func(i int) set.Element { return hEle(i) },
+		func(i int) set.Element { return collide(i) },
+		func(i int) set.Element { return intEle(i) }, // no Hasher
+	} {
+		rnd := rand.New(rand.NewSource(1))
+		var p set.SetP
+		var m set.SetM
+		var versions []set.SetP
+		var models []set.SetM
+		for i := 0; i < 400; i++ {
+			e := mk(rnd.Intn(100))
+			if rnd.Intn(3) == 0 {
+				p = p.Remove(e)
+				m = m.Copy()
+				m.Remove(e)
+			} else {
+				p = p.Add(e)
+				m = m.Copy()
+				m.Add(e)
+			}
+			if !p.Ok() || p.Cardinality() != len(m) {
+				t.Fatalf("%T: step %d: Ok %t, cardinality %d, want %d",
+					e, i, p.Ok(), p.Cardinality(), len(m))
+			}
+			if i%20 == 0 {
+				versions = append(versions, p)
+				models = append(models, m)
+			}
+		}
+		// old versions are unchanged
+		for i, v := range versions {
+			if !v.SetM().Equal(models[i]) {
+				t.Fatalf("%T: version %d changed", mk(0), i)
+			}
+		}
+		// set operations agree with SetM
+		for i := range versions {
+			for j := range versions {
+				a, b := versions[i], versions[j]
+				ma, mb := models[i], models[j]
+				if u := a.Union(b); !u.Ok() || !u.SetM().Equal(ma.Union(mb)) {
+					t.Fatalf("%T: Union %d %d", mk(0), i, j)
+				}
+				if x := a.Intersect(b); !x.Ok() || !x.SetM().Equal(ma.Intersect(mb)) {
+					t.Fatalf("%T: Intersect %d %d", mk(0), i, j)
+				}
+				if d := a.Difference(b); !d.Ok() || !d.SetM().Equal(ma.Difference(mb)) {
+					t.Fatalf("%T: Difference %d %d", mk(0), i, j)
+				}
+				if a.Equal(b) != ma.Equal(mb) {
+					t.Fatalf("%T: Equal %d %d", mk(0), i, j)
+				}
+			}
+		}
+	}
+}
+
+func TestSetPShape(t *testing.T) {
+	// equal sets built in different orders compare equal
+	a := set.NewSetP(wEle(1), wEle(33), wEle(1025), collide(1), collide(6))
+	b := set.NewSetP(collide(6), wEle(1025), collide(1), wEle(33), wEle(1))
+	if !a.Equal(b) || a.Hash() != b.Hash() {
+		t.Fatal("order dependence")
+	}
+	// removing restores the original shape
+	c := a.Add(wEle(65)).Remove(wEle(65))
+	if !c.Equal(a) || !c.Ok() {
+		t.Fatal("Add, Remove")
+	}
+	if a.Add(wEle(1)) != a || a.Remove(wEle(2)) != a {
+		t.Fatal("no-op did not return receiver")
+	}
+	if a.Union(a) != a || a.Intersect(a) != a || !a.Difference(a).IsEmpty() {
+		t.Fatal("self operations")
+	}
+	d := a.Add(wEle(2))
+	if d.Union(a) != d || d.Intersect(a).Cardinality() != 5 {
+		t.Fatal("derived version operations")
+	}
+	// sets of sets
+	s := set.NewSetP(a, b, c, d)
+	if s.Cardinality() != 2 || !s.HasElement(set.NewSetP(wEle(1), wEle(2), wEle(33), wEle(1025), collide(1), collide(6))) {
+		t.Fatal("sets of sets:", s)
+	}
+}
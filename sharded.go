@@ -0,0 +1,121 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"iter"
+	"sync"
+)
+
+// ShardedSet is a set safe for concurrent use, designed for many goroutines
+// accessing it at once.
+//
+// Elements are spread over a number of shards by hash, each shard a SetH
+// with its own lock.  Goroutines working on elements in different shards do
+// not contend.  Elements should implement Hasher.  Elements not implementing
+// Hasher all land in a single shard, where ShardedSet performs no better than
+// SyncSet.
+//
+// Operations on single elements are atomic.  Operations over the whole set,
+// such as Cardinality and Snapshot, lock shards one at a time and so do not
+// see a consistent state if the set is concurrently modified.
+type ShardedSet struct {
+	shards []shard
+	mask   uint64
+}
+
+type shard struct {
+	mu sync.RWMutex
+	s  SetH
+	_  [64]byte // keep shards on separate cache lines
+}
+
+// NewShardedSet returns a new empty ShardedSet with n shards.
+//
+// n is rounded up to a power of 2.  A good choice is a small multiple of
+// the number of goroutines expected to access the set concurrently.
+func NewShardedSet(n int) *ShardedSet {
+	m := 1
+	for m < n {
+		m <<= 1
+	}
+	return &ShardedSet{shards: make([]shard, m), mask: uint64(m - 1)}
+}
+
+// shard returns the shard for e.
+func (p *ShardedSet) shard(e Element) *shard {
+	return &p.shards[mix(hash(e))&p.mask]
+}
+
+// Add adds e to the set if it is not already present.
+//
+// Returns true if e was added.  Returns false if e was already present.
+func (p *ShardedSet) Add(e Element) bool {
+	sh := p.shard(e)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.s.Add(e)
+}
+
+// All returns an iterator over elements of the set.
+//
+// Each shard is snapshotted as iteration reaches it.  No lock is held while
+// the loop body runs.
+func (p *ShardedSet) All() iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		for i := range p.shards {
+			for _, e := range p.shards[i].snapshot() {
+				if !yield(e) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Cardinality returns the number of elements in the set.
+func (p *ShardedSet) Cardinality() (n int) {
+	for i := range p.shards {
+		sh := &p.shards[i]
+		sh.mu.RLock()
+		n += sh.s.Cardinality()
+		sh.mu.RUnlock()
+	}
+	return
+}
+
+// HasElement returns true if the set contains e.
+func (p *ShardedSet) HasElement(e Element) bool {
+	sh := p.shard(e)
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.s.HasElement(e)
+}
+
+// Remove removes e from the set if it is present.
+//
+// Returns true if e was removed.  Returns false if e was not present.
+func (p *ShardedSet) Remove(e Element) bool {
+	sh := p.shard(e)
+	sh.mu.Lock()
+	defer sh.mu.Unlock()
+	return sh.s.Remove(e)
+}
+
+// Snapshot returns a new SetM with the elements of the set.
+func (p *ShardedSet) Snapshot() (s SetM) {
+	for i := range p.shards {
+		s = append(s, p.shards[i].snapshot()...)
+	}
+	return
+}
+
+func (sh *shard) snapshot() SetM {
+	sh.mu.RLock()
+	defer sh.mu.RUnlock()
+	return sh.s.SetM()
+}
+
+// String satisfies fmt.Stringer, printing a snapshot of the set.
+func (p *ShardedSet) String() string { return p.Snapshot().String() }
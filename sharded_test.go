@@ -0,0 +1,143 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"math/rand"
+	"runtime"
+	"sync"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+// integer type satisfying Hasher, with a well distributed hash
+type wEle int
+
+func (i wEle) Equal(e set.Element) bool {
+	j, ok := e.(wEle)
+	return ok && i == j
+}
+
+func (i wEle) Hash() uint64 { return uint64(i) }
+
+func TestShardedSet(t *testing.T) {
+	s := set.NewShardedSet(5)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	added := 0
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n := 0
+			for i := 0; i < 200; i++ {
+				if s.Add(wEle(i)) {
+					n++
+				}
+				s.HasElement(wEle(i))
+			}
+			mu.Lock()
+			added += n
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+	if added != 200 || s.Cardinality() != 200 {
+		t.Fatal("Add:", added, s.Cardinality())
+	}
+	snap := s.Snapshot()
+	if !snap.Ok() || len(snap) != 200 {
+		t.Fatal("Snapshot")
+	}
+	for i := 0; i < 200; i += 2 {
+		if !s.Remove(wEle(i)) || s.Remove(wEle(i)) {
+			t.Fatal("Remove")
+		}
+	}
+	n := 0
+	for e := range s.All() {
+		if e.(wEle)%2 == 0 {
+			t.Fatal("removed element present:", e)
+		}
+		n++
+	}
+	if n != 100 {
+		t.Fatal("All:", n)
+	}
+}
+
+// contention benchmarks:  many goroutines adding and looking up elements
+// from a fixed key space.  Compare BenchmarkShardedSet with
+// BenchmarkShardedSet1 or BenchmarkLockedSetH to see the effect of lock
+// striping.
+
+const benchKeys = 4096
+
+func BenchmarkShardedSet(b *testing.B) {
+	s := set.NewShardedSet(4 * runtime.GOMAXPROCS(0))
+	b.RunParallel(func(pb *testing.PB) {
+		r := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			e := wEle(r.Intn(benchKeys))
+			if !s.HasElement(e) {
+				s.Add(e)
+			}
+		}
+	})
+}
+
+// BenchmarkShardedSet1 is the single lock baseline for BenchmarkShardedSet.
+// With one shard, the data structure is the same SetH and only lock
+// striping differs.
+func BenchmarkShardedSet1(b *testing.B) {
+	s := set.NewShardedSet(1)
+	b.RunParallel(func(pb *testing.PB) {
+		r := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			e := wEle(r.Intn(benchKeys))
+			if !s.HasElement(e) {
+				s.Add(e)
+			}
+		}
+	})
+}
+
+// BenchmarkLockedSetH is a single sync.RWMutex around a SetH, the same
+// baseline as BenchmarkShardedSet1 without the ShardedSet code.
+func BenchmarkLockedSetH(b *testing.B) {
+	var mu sync.RWMutex
+	var s set.SetH
+	b.RunParallel(func(pb *testing.PB) {
+		r := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			e := wEle(r.Intn(benchKeys))
+			mu.RLock()
+			has := s.HasElement(e)
+			mu.RUnlock()
+			if !has {
+				mu.Lock()
+				s.Add(e)
+				mu.Unlock()
+			}
+		}
+	})
+}
+
+// BenchmarkSyncSet compares with SyncSet, a lock around a SetM.  It
+// measures the cost of the SetM data structure, copy-on-write Add and
+// linear HasElement, as well as the lock, and so is not a measure of lock
+// striping alone.
+func BenchmarkSyncSet(b *testing.B) {
+	var s set.SyncSet
+	b.RunParallel(func(pb *testing.PB) {
+		r := rand.New(rand.NewSource(rand.Int63()))
+		for pb.Next() {
+			e := wEle(r.Intn(benchKeys))
+			if !s.HasElement(e) {
+				s.AddIfAbsent(e)
+			}
+		}
+	})
+}
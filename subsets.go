@@ -0,0 +1,167 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"context"
+	"iter"
+)
+
+// SubsetOrder selects the order in which SetM.EnumerateSubsets yields subsets.
+type SubsetOrder int
+
+const (
+	// BinaryOrder yields subsets in counting order.  Element i of the set
+	// is in the j-th subset yielded when bit i of j is 1.
+	BinaryOrder SubsetOrder = iota
+	// GrayOrder yields subsets in reflected Gray code order.  Consecutive
+	// subsets differ by the addition or removal of a single element.
+	GrayOrder
+	// SizeOrder yields subsets by increasing size.  Subsets of the same size
+	// are yielded in lexicographic order of element indexes.
+	SizeOrder
+)
+
+// SubsetOptions control SetM.EnumerateSubsets.
+//
+// Only subsets with cardinality between MinSize and MaxSize inclusive are
+// yielded.  A MaxSize of zero or less means no upper limit.  To enumerate
+// just the empty set, use SetM.Combinations(0).
+//
+// With BinaryOrder and GrayOrder, size limits filter the 2^n subsets but
+// all 2^n steps are still taken.  With SizeOrder, only subsets within the
+// limits are visited.
+type SubsetOptions struct {
+	Order            SubsetOrder
+	MinSize, MaxSize int
+}
+
+// EnumerateSubsets returns an iterator over subsets of s.
+//
+// Unlike SetM.PowerSet, subsets are constructed one at a time as they are
+// yielded, so memory use is proportional to len(s), not 2^len(s).
+// Each yielded set is newly allocated.
+//
+// Enumeration stops early if ctx is cancelled.  Use ctx.Err() after the
+// range loop to distinguish cancellation from completion.
+func (s SetM) EnumerateSubsets(ctx context.Context, o SubsetOptions) iter.Seq[SetM] {
+	min, max := o.MinSize, o.MaxSize
+	if min < 0 {
+		min = 0
+	}
+	if max <= 0 || max > len(s) {
+		max = len(s)
+	}
+	if o.Order == SizeOrder {
+		return func(yield func(SetM) bool) {
+			for k := min; k <= max; k++ {
+				if !s.combinations(ctx, k, yield) {
+					return
+				}
+			}
+		}
+	}
+	return func(yield func(SetM) bool) {
+		done := ctx.Done()
+		in := make([]bool, len(s))  // elements of current subset
+		ctr := make([]bool, len(s)) // binary counter
+		size := 0
+		for {
+			if done != nil {
+				select {
+				case <-done:
+					return
+				default:
+				}
+			}
+			if size >= min && size <= max {
+				sub := make(SetM, 0, size)
+				for i, b := range in {
+					if b {
+						sub = append(sub, s[i])
+					}
+				}
+				if !yield(sub) {
+					return
+				}
+			}
+			// increment the counter.  p is the bit position where the
+			// carry stops.
+			p := 0
+			for p < len(ctr) && ctr[p] {
+				ctr[p] = false
+				p++
+			}
+			if p == len(ctr) {
+				return
+			}
+			ctr[p] = true
+			if o.Order == GrayOrder {
+				// the Gray code for the counter differs from the
+				// previous in exactly bit p.
+				if in[p] = !in[p]; in[p] {
+					size++
+				} else {
+					size--
+				}
+			} else {
+				copy(in, ctr)
+				size += 1 - p
+			}
+		}
+	}
+}
+
+// Combinations returns an iterator over the subsets of s with exactly k
+// elements.
+//
+// Subsets are yielded in lexicographic order of element indexes.
+// Each yielded set is newly allocated.
+func (s SetM) Combinations(k int) iter.Seq[SetM] {
+	return func(yield func(SetM) bool) {
+		s.combinations(context.Background(), k, yield)
+	}
+}
+
+// combinations calls yield with each k-subset of s.  It returns false if
+// yield returned false or ctx was cancelled.
+func (s SetM) combinations(ctx context.Context, k int, yield func(SetM) bool) bool {
+	if k < 0 || k > len(s) {
+		return true
+	}
+	done := ctx.Done()
+	x := make([]int, k)
+	for i := range x {
+		x[i] = i
+	}
+	for {
+		if done != nil {
+			select {
+			case <-done:
+				return false
+			default:
+			}
+		}
+		sub := make(SetM, k)
+		for i, j := range x {
+			sub[i] = s[j]
+		}
+		if !yield(sub) {
+			return false
+		}
+		// advance to the next combination: find the rightmost index
+		// that can be incremented, then reset those after it.
+		i := k - 1
+		for i >= 0 && x[i] == len(s)-k+i {
+			i--
+		}
+		if i < 0 {
+			return true
+		}
+		x[i]++
+		for j := i + 1; j < k; j++ {
+			x[j] = x[j-1] + 1
+		}
+	}
+}
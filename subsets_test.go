@@ -0,0 +1,82 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func TestEnumerateSubsets(t *testing.T) {
+	s := set.NewSetM(intEle(1), intEle(2), intEle(3), intEle(4), intEle(5))
+	ps := s.PowerSet()
+	for _, order := range []set.SubsetOrder{set.BinaryOrder, set.GrayOrder, set.SizeOrder} {
+		var got set.SetM
+		var prev set.SetM
+		for sub := range s.EnumerateSubsets(context.Background(), set.SubsetOptions{Order: order}) {
+			if !got.Add(sub) {
+				t.Fatal(order, "duplicate subset:", sub)
+			}
+			if order == set.GrayOrder && prev != nil {
+				if len(sub.SymmetricDifference(prev)) != 1 {
+					t.Fatal("Gray order step:", prev, sub)
+				}
+			}
+			prev = sub
+		}
+		if !got.Equal(ps) {
+			t.Fatal(order, "not the power set:", got)
+		}
+	}
+}
+
+func TestEnumerateSubsetsSize(t *testing.T) {
+	s := set.NewSetM(intEle(1), intEle(2), intEle(3), intEle(4), intEle(5))
+	for _, order := range []set.SubsetOrder{set.BinaryOrder, set.GrayOrder, set.SizeOrder} {
+		n := 0
+		o := set.SubsetOptions{Order: order, MinSize: 2, MaxSize: 3}
+		for sub := range s.EnumerateSubsets(context.Background(), o) {
+			if len(sub) < 2 || len(sub) > 3 {
+				t.Fatal(order, "size:", sub)
+			}
+			n++
+		}
+		if n != 20 { // C(5,2) + C(5,3)
+			t.Fatal(order, "count:", n)
+		}
+	}
+	var got set.SetM
+	for c := range s.Combinations(2) {
+		got.Add(c)
+	}
+	if len(got) != 10 || !got.Ok() {
+		t.Fatal("Combinations:", got)
+	}
+	for c := range s.Combinations(0) {
+		if len(c) != 0 {
+			t.Fatal("Combinations(0):", c)
+		}
+	}
+}
+
+func TestEnumerateSubsetsCancel(t *testing.T) {
+	// 2^40 subsets would never finish.  Cancellation must stop it.
+	var s set.SetM
+	for i := 0; i < 40; i++ {
+		s = append(s, intEle(i))
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	n := 0
+	for range s.EnumerateSubsets(ctx, set.SubsetOptions{Order: set.GrayOrder}) {
+		if n++; n == 1000 {
+			cancel()
+		}
+	}
+	if n != 1000 || ctx.Err() == nil {
+		t.Fatal("not cancelled:", n)
+	}
+}
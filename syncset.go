@@ -0,0 +1,136 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncSet is a set safe for concurrent use by multiple goroutines.
+//
+// SyncSet wraps a SetM with a read/write lock.  The wrapped SetM is never
+// modified in place.  Each change replaces it with a new slice, so a
+// snapshot is just the current slice and remains valid, unchanged, while
+// the SyncSet is further modified.
+//
+// The zero value is an empty set ready to use.  A SyncSet must not be copied
+// after first use.
+type SyncSet struct {
+	mu sync.RWMutex
+	s  SetM
+}
+
+// NewSyncSet returns a new SyncSet with the given elements.
+func NewSyncSet(es ...Element) *SyncSet {
+	return &SyncSet{s: NewSetM(es...)}
+}
+
+// AddIfAbsent adds e to the set if it is not already present.
+//
+// Returns true if e was added.  Returns false if e was already present.
+// The test and the addition are a single atomic operation.
+func (p *SyncSet) AddIfAbsent(e Element) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	// SetM.Add always allocates a new backing array.
+	return p.s.Add(e)
+}
+
+// All returns an iterator over a snapshot of the set.
+//
+// The elements yielded are those present when iteration begins.
+// Concurrent changes to the set do not affect the iteration, and the set
+// is not locked during iteration, so the loop body may itself modify p.
+func (p *SyncSet) All() iter.Seq[Element] {
+	return func(yield func(Element) bool) {
+		for _, e := range p.Snapshot() {
+			if !yield(e) {
+				return
+			}
+		}
+	}
+}
+
+// Cardinality returns the number of elements in the set.
+func (p *SyncSet) Cardinality() int {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return len(p.s)
+}
+
+// CompareAndSwap replaces the contents of the set with s if the set is
+// currently equal to old.
+//
+// Returns true if the swap was made.  The comparison uses SetM.Equal, so
+// old need not be a snapshot from p, only a set with the same elements.
+func (p *SyncSet) CompareAndSwap(old, s SetM) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.s.Equal(old) {
+		return false
+	}
+	p.s = s.Copy()
+	return true
+}
+
+// HasElement returns true if the set contains e.
+func (p *SyncSet) HasElement(e Element) bool {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.s.HasElement(e)
+}
+
+// RemoveIfPresent removes e from the set if it is present.
+//
+// Returns true if e was removed.  Returns false if e was not present.
+// The test and the removal are a single atomic operation.
+func (p *SyncSet) RemoveIfPresent(e Element) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if !p.s.HasElement(e) {
+		return false
+	}
+	// SetM.Remove works in place, so remove from a copy.
+	s := p.s.Copy()
+	s.Remove(e)
+	p.s = s
+	return true
+}
+
+// Snapshot returns the current contents of the set.
+//
+// The result is shared with the SyncSet and with other snapshots and must
+// be treated as read-only.  Copy it before modifying it.  The SyncSet never
+// modifies it.
+func (p *SyncSet) Snapshot() SetM {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.s
+}
+
+// String satisfies fmt.Stringer, printing a snapshot of the set.
+func (p *SyncSet) String() string { return p.Snapshot().String() }
+
+// Swap replaces the contents of the set with s and returns the old
+// contents.
+//
+// The result is a copy, not shared with snapshots, and may be modified.
+func (p *SyncSet) Swap(s SetM) (old SetM) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	old, p.s = p.s.Copy(), s.Copy()
+	return
+}
+
+// Update replaces the contents of the set with the result of f, as a single
+// atomic operation.
+//
+// f is called with the write lock held and must not call methods of p.
+// The argument to f is a copy that f may modify and return.
+func (p *SyncSet) Update(f func(SetM) SetM) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.s = f(p.s.Copy()).Copy()
+}
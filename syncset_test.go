@@ -0,0 +1,120 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+// Run with -race to check for data races.
+func TestSyncSet(t *testing.T) {
+	var s set.SyncSet
+	var wg sync.WaitGroup
+	var added [8]int
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if s.AddIfAbsent(intEle(i)) {
+					added[g]++
+				}
+				s.HasElement(intEle(i))
+				for range s.All() {
+					break
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	n := 0
+	for _, a := range added {
+		n += a
+	}
+	if n != 100 || s.Cardinality() != 100 || !s.Snapshot().Ok() {
+		t.Fatal("AddIfAbsent:", n, s.Cardinality())
+	}
+
+	removed := make([]int, 8)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				if s.RemoveIfPresent(intEle(i)) {
+					removed[g]++
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+	n = 0
+	for _, r := range removed {
+		n += r
+	}
+	if n != 100 || s.Cardinality() != 0 {
+		t.Fatal("RemoveIfPresent:", n, s.Cardinality())
+	}
+}
+
+func TestSyncSetSnapshot(t *testing.T) {
+	s := set.NewSyncSet(intEle(1), intEle(2), intEle(3))
+	snap := s.Snapshot()
+	n := 0
+	for e := range s.All() {
+		// modifying during iteration does not affect it
+		s.RemoveIfPresent(e)
+		s.AddIfAbsent(intEle(10 + n))
+		n++
+	}
+	if n != 3 || len(snap) != 3 || !snap.Equal(set.SetM{intEle(1), intEle(2), intEle(3)}) {
+		t.Fatal("snapshot changed:", snap)
+	}
+	if !s.Snapshot().Equal(set.SetM{intEle(10), intEle(11), intEle(12)}) {
+		t.Fatal("after iteration:", s)
+	}
+}
+
+func TestSyncSetCAS(t *testing.T) {
+	s := set.NewSyncSet(intEle(1))
+	// concurrent increments of a counter set by compare and swap
+	var wg sync.WaitGroup
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				for {
+					old := s.Snapshot()
+					n := old[0].(intEle)
+					if s.CompareAndSwap(old, set.SetM{n + 1}) {
+						break
+					}
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	if !s.Snapshot().Equal(set.SetM{intEle(401)}) {
+		t.Fatal("CompareAndSwap:", s)
+	}
+	s.Update(func(m set.SetM) set.SetM {
+		m.Add(intEle(0))
+		return m
+	})
+	snap := s.Snapshot()
+	first := snap[0]
+	old := s.Swap(nil)
+	if len(old) != 2 || s.Cardinality() != 0 {
+		t.Fatal("Update, Swap:", old)
+	}
+	// old is not shared with earlier snapshots.
+	old.Remove(first)
+	if snap[0] != first {
+		t.Fatal("Swap result shares snapshot:", snap)
+	}
+}
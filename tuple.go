@@ -0,0 +1,118 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set
+
+import "iter"
+
+// Tuple is an ordered n-tuple of elements.
+//
+// The type is an element type used for n-ary cartesian products.
+// Unlike OrderedPair, which has exactly two components, a Tuple may have
+// any number of components.
+type Tuple []Element
+
+// Equal satisfies the Element interface, allowing Tuple values to be elements
+// of sets.
+//
+// Tuples are equal if they have the same length and components at each
+// position are equal by their Equal methods.  A nil component is equal
+// only to nil.
+func (t Tuple) Equal(e Element) bool {
+	u, ok := e.(Tuple)
+	if !ok || len(t) != len(u) {
+		return false
+	}
+	for i, c := range t {
+		if !equalOrNil(c, u[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Hash satisfies the Hasher interface, combining hashes of the tuple
+// components in order.  Components not implementing Hasher contribute a
+// hash of 0.
+func (t Tuple) Hash() (h uint64) {
+	for _, c := range t {
+		h = mix(h)*31 + hash(c)
+	}
+	return
+}
+
+// Project returns a new tuple of the components of t at indexes is.
+//
+// Indexes may be repeated or given in any order.  Project panics if an index
+// is out of range.
+func (t Tuple) Project(is ...int) Tuple {
+	p := make(Tuple, len(is))
+	for j, i := range is {
+		p[j] = t[i]
+	}
+	return p
+}
+
+// CartesianProductV returns an iterator over the cartesian product of s and
+// all sets ts.
+//
+// Each yielded Tuple has len(ts)+1 components, the first from s and the
+// remainder from the corresponding sets of ts.  Tuples are constructed one
+// at a time as they are yielded.  The product is empty if any set is empty.
+//
+// See SetM.CartesianProduct for a binary product of OrderedPairs.
+func (s SetM) CartesianProductV(ts ...SetM) iter.Seq[Tuple] {
+	ss := append([]SetM{s}, ts...)
+	return func(yield func(Tuple) bool) {
+		for _, f := range ss {
+			if len(f) == 0 {
+				return
+			}
+		}
+		x := make([]int, len(ss))
+		for {
+			t := make(Tuple, len(ss))
+			for i, j := range x {
+				t[i] = ss[i][j]
+			}
+			if !yield(t) {
+				return
+			}
+			// advance the odometer, last component fastest
+			i := len(x) - 1
+			for ; i >= 0; i-- {
+				if x[i]++; x[i] < len(ss[i]) {
+					break
+				}
+				x[i] = 0
+			}
+			if i < 0 {
+				return
+			}
+		}
+	}
+}
+
+// Project returns the set of components at index i of the elements of s.
+//
+// Elements of s are expected to be Tuples or OrderedPairs.  For an
+// OrderedPair, index 0 selects A and index 1 selects B.  Elements of other
+// types, or without a component at index i, are ignored.
+func (s SetM) Project(i int) (p SetM) {
+	for _, e := range s {
+		switch t := e.(type) {
+		case Tuple:
+			if i >= 0 && i < len(t) {
+				p.Add(t[i])
+			}
+		case OrderedPair:
+			switch i {
+			case 0:
+				p.Add(t.A)
+			case 1:
+				p.Add(t.B)
+			}
+		}
+	}
+	return
+}
@@ -0,0 +1,59 @@
+// Copyright 2026 Sonia Keys
+// License MIT: http://opensource.org/licenses/MIT
+
+package set_test
+
+import (
+	"testing"
+
+	"github.com/soniakeys/set"
+)
+
+func TestTuple(t *testing.T) {
+	a := set.Tuple{intEle(1), set.SetM{intEle(2), intEle(3)}}
+	b := set.Tuple{intEle(1), set.SetM{intEle(3), intEle(2)}}
+	if !a.Equal(b) || a.Hash() != b.Hash() {
+		t.Fatal("Equal")
+	}
+	if a.Equal(set.Tuple{intEle(1)}) || a.Equal(intEle(1)) {
+		t.Fatal("not Equal")
+	}
+	if p := a.Project(1, 0); !p.Equal(set.Tuple{set.SetM{intEle(2), intEle(3)}, intEle(1)}) {
+		t.Fatal("Project:", p)
+	}
+	// Nil components.
+	z := set.Tuple{nil, intEle(1)}
+	if !z.Equal(set.Tuple{nil, intEle(1)}) || z.Equal(set.Tuple{intEle(1), intEle(1)}) {
+		t.Fatal("nil component")
+	}
+	if (set.Tuple{intEle(1), intEle(1)}).Equal(z) || z.Hash() != (set.Tuple{nil, intEle(1)}).Hash() {
+		t.Fatal("nil compared with non-nil")
+	}
+}
+
+func TestCartesianProductV(t *testing.T) {
+	s := set.NewSetM(intEle(1), intEle(2))
+	u := set.NewSetM(intEle(3), intEle(4), intEle(5))
+	v := set.NewSetM(set.SetM{}, set.SetM{intEle(6)})
+	var p set.SetM
+	for tu := range s.CartesianProductV(u, v) {
+		if len(tu) != 3 {
+			t.Fatal("tuple length:", tu)
+		}
+		if !p.Add(tu) {
+			t.Fatal("duplicate tuple:", tu)
+		}
+	}
+	if len(p) != 12 {
+		t.Fatal("product size:", len(p))
+	}
+	if !p.Project(0).Equal(s) || !p.Project(1).Equal(u) || !p.Project(2).Equal(v) {
+		t.Fatal("Project")
+	}
+	for range s.CartesianProductV(u, set.SetM{}) {
+		t.Fatal("product with empty set not empty")
+	}
+	if c := s.CartesianProduct(u).Project(1); !c.Equal(u) {
+		t.Fatal("Project pairs:", c)
+	}
+}